@@ -0,0 +1,153 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// Statuses of the asynchronous release and bulk actions.
+const (
+	actionCreated    = "created"
+	actionInProgress = "inProgress"
+	actionSucceeded  = "succeeded"
+	actionFailed     = "failed"
+)
+
+// asyncAction is the part of a release action or bulk action that tells how
+// it went.
+type asyncAction struct {
+	Sys    *asyncActionSys   `json:"sys"`
+	Action string            `json:"action"`
+	Error  *asyncActionError `json:"error,omitempty"`
+}
+
+type asyncActionSys struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type asyncActionError struct {
+	Sys     *contentful.Sys `json:"sys"`
+	Message string          `json:"message"`
+	Details *struct {
+		Errors []*asyncEntityError `json:"errors"`
+	} `json:"details"`
+}
+
+// asyncEntityError is the failure of an action for one of its entities.
+type asyncEntityError struct {
+	Entity *link `json:"entity"`
+	Error  *struct {
+		Sys     *contentful.Sys `json:"sys"`
+		Message string          `json:"message"`
+		Details *struct {
+			Errors []*validationError `json:"errors"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// validationError is a field of an entity that failed validation.
+type validationError struct {
+	Name    string        `json:"name"`
+	Path    []interface{} `json:"path"`
+	Details string        `json:"details"`
+}
+
+// waitForAsyncAction polls the action at path until it has succeeded or
+// failed. A failed action is returned together with an error listing the
+// entities it failed for.
+func waitForAsyncAction(client *contentful.Client, path string, timeout time.Duration) (*asyncAction, error) {
+	conf := &resource.StateChangeConf{
+		Pending:    []string{actionCreated, actionInProgress},
+		Target:     []string{actionSucceeded, actionFailed},
+		Timeout:    timeout,
+		MinTimeout: time.Second,
+		Refresh: func() (interface{}, string, error) {
+			var action asyncAction
+			err := retryTransient(timeout, func() error {
+				return cmaRequest(client, http.MethodGet, path, nil, 0, nil, &action)
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			return &action, action.Sys.Status, nil
+		},
+	}
+
+	result, err := conf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+
+	action := result.(*asyncAction)
+	if action.Sys.Status == actionFailed {
+		return action, action.failure()
+	}
+
+	return action, nil
+}
+
+// failure describes why the action failed, with a line per entity.
+func (a *asyncAction) failure() error {
+	message := fmt.Sprintf("%s action %s failed", a.Action, a.Sys.ID)
+	if a.Error == nil {
+		return fmt.Errorf("%s", message)
+	}
+
+	if a.Error.Message != "" {
+		message += ": " + a.Error.Message
+	}
+
+	if a.Error.Details == nil {
+		return fmt.Errorf("%s", message)
+	}
+
+	for _, entityError := range a.Error.Details.Errors {
+		message += "\n  - " + entityError.String()
+	}
+
+	return fmt.Errorf("%s", message)
+}
+
+func (e *asyncEntityError) String() string {
+	var description string
+	if e.Entity != nil && e.Entity.Sys != nil {
+		description = fmt.Sprintf("%s %s", strings.ToLower(e.Entity.Sys.LinkType), e.Entity.Sys.ID)
+	}
+
+	if e.Error == nil {
+		return description
+	}
+
+	if e.Error.Sys != nil {
+		description += ": " + e.Error.Sys.ID
+	}
+
+	if e.Error.Message != "" {
+		description += ": " + e.Error.Message
+	}
+
+	if e.Error.Details != nil {
+		var fields []string
+		for _, validation := range e.Error.Details.Errors {
+			var path []string
+			for _, segment := range validation.Path {
+				path = append(path, fmt.Sprint(segment))
+			}
+
+			fields = append(fields, fmt.Sprintf("%s %s", strings.Join(path, "."), validation.Name))
+		}
+
+		if len(fields) > 0 {
+			description += " (" + strings.Join(fields, ", ") + ")"
+		}
+	}
+
+	return description
+}
@@ -0,0 +1,49 @@
+package contentful
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAsyncActionFailure(t *testing.T) {
+	var action asyncAction
+	err := json.Unmarshal([]byte(`{
+  "sys": {"id": "action1", "status": "failed"},
+  "action": "publish",
+  "error": {
+    "sys": {"type": "Error", "id": "BadRequest"},
+    "message": "Some entities failed",
+    "details": {
+      "errors": [
+        {
+          "entity": {"sys": {"type": "Link", "linkType": "Entry", "id": "entry1"}},
+          "error": {
+            "sys": {"type": "Error", "id": "ValidationFailed"},
+            "message": "Validation error",
+            "details": {"errors": [{"name": "required", "path": ["fields", "title", "en-US"]}]}
+          }
+        },
+        {
+          "entity": {"sys": {"type": "Link", "linkType": "Asset", "id": "asset1"}},
+          "error": {"sys": {"type": "Error", "id": "NotFound"}}
+        }
+      ]
+    }
+  }
+}`), &action)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := action.failure().Error()
+	for _, expected := range []string{
+		"publish action action1 failed: Some entities failed",
+		"entry entry1: ValidationFailed: Validation error (fields.title.en-US required)",
+		"asset asset1: NotFound",
+	} {
+		if !strings.Contains(message, expected) {
+			t.Errorf("expected %q in %q", expected, message)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package contentful
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// httpClient is shared by contentful-go and cmaRequest.
+var httpClient = newHTTPClient()
+
+// cmaRequest performs a request against the Content Management API for the
+// endpoints that contentful-go does not cover, or does not cover correctly,
+// like tags, taxonomies, releases and scheduled actions. Their objects are
+// decoded into the types of this package instead. It reuses the base URL and
+// headers of the configured client. A version greater than zero is sent as
+// X-Contentful-Version, and the response body is decoded into v when given.
+func cmaRequest(client *contentful.Client, method, path string, query url.Values, version int, body, v interface{}) error {
+	return doCMARequest(client, method, path, query, version, nil, body, v)
+}
+
+// patchOperation is an operation of a JSON Patch document.
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// cmaPatch applies a JSON Patch to the object at path. The taxonomy endpoints
+// only accept updates in this form.
+func cmaPatch(client *contentful.Client, path string, version int, operations []patchOperation, v interface{}) error {
+	return doCMARequest(client, http.MethodPatch, path, nil, version, map[string]string{"Content-Type": "application/json-patch+json"}, operations, v)
+}
+
+// cmaErrorIDs are the IDs of the Content Management API errors that are
+// handled by status, for error responses without a JSON body, like the ones
+// of a gateway.
+var cmaErrorIDs = map[int]string{
+	http.StatusNotFound:            "NotFound",
+	http.StatusConflict:            "VersionMismatch",
+	http.StatusTooManyRequests:     "RateLimitExceeded",
+	http.StatusInternalServerError: "ServerError",
+	http.StatusBadGateway:          "BadGateway",
+	http.StatusServiceUnavailable:  "ServiceUnavailable",
+}
+
+// doCMARequest performs a request like cmaRequest. The given headers are sent
+// in addition to, or instead of, the ones of the client.
+func doCMARequest(client *contentful.Client, method, path string, query url.Values, version int, headers map[string]string, body, v interface{}) error {
+	u, err := url.Parse(client.BaseURL)
+	if err != nil {
+		return err
+	}
+
+	u.Path = path
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		bytesArray, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewReader(bytesArray)
+	}
+
+	req, err := http.NewRequest(method, u.String(), reader)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range client.Headers {
+		req.Header.Set(key, value)
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	if version > 0 {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(version))
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode < 400 {
+		if v == nil || res.StatusCode == http.StatusNoContent {
+			return nil
+		}
+
+		return json.NewDecoder(res.Body).Decode(v)
+	}
+
+	var errorResponse contentful.ErrorResponse
+	if err := json.NewDecoder(res.Body).Decode(&errorResponse); err != nil || errorResponse.Sys == nil {
+		id, ok := cmaErrorIDs[res.StatusCode]
+		if !ok {
+			id = http.StatusText(res.StatusCode)
+		}
+
+		errorResponse = contentful.ErrorResponse{
+			Sys:     &contentful.Sys{ID: id},
+			Message: res.Status,
+		}
+	}
+
+	if errorResponse.Sys.ID == "NotFound" {
+		return contentful.NotFoundError{}
+	}
+
+	return errorResponse
+}
+
+// link is a reference to another Contentful object, as used in the sys and
+// metadata properties of the API payloads.
+type link struct {
+	Sys *contentful.Sys `json:"sys"`
+}
+
+func newLink(linkType, id string) *link {
+	return &link{
+		Sys: &contentful.Sys{
+			ID:       id,
+			Type:     "Link",
+			LinkType: linkType,
+		},
+	}
+}
+
+// cmaCollection is a page of a collection endpoint.
+type cmaCollection struct {
+	Total int               `json:"total"`
+	Skip  int               `json:"skip"`
+	Limit int               `json:"limit"`
+	Items []json.RawMessage `json:"items"`
+}
+
+// cmaListAll pages through the collection at path and decodes every item into
+// v, which must be a pointer to a slice.
+func cmaListAll(client *contentful.Client, path string, query url.Values, v interface{}) error {
+	if query == nil {
+		query = url.Values{}
+	}
+
+	var items []json.RawMessage
+	for {
+		query.Set("skip", strconv.Itoa(len(items)))
+		query.Set("limit", "100")
+
+		var page cmaCollection
+		if err := cmaRequest(client, http.MethodGet, path, query, 0, nil, &page); err != nil {
+			return err
+		}
+
+		items = append(items, page.Items...)
+
+		if len(page.Items) == 0 || len(items) >= page.Total {
+			break
+		}
+	}
+
+	bytesArray, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(bytesArray, v)
+}
+
+// cmaListByIDs lists the objects of the collection at path with the given
+// IDs and decodes them into v, which must be a pointer to a slice. IDs that do
+// not exist are left out. The IDs are sent in the query string, which limits
+// how many fit in a request.
+func cmaListByIDs(client *contentful.Client, path string, ids []string, v interface{}) error {
+	var items []json.RawMessage
+	for start := 0; start < len(ids); start += 100 {
+		end := start + 100
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		var page []json.RawMessage
+		query := url.Values{"sys.id[in]": {strings.Join(ids[start:end], ",")}}
+		if err := cmaListAll(client, path, query, &page); err != nil {
+			return err
+		}
+
+		items = append(items, page...)
+	}
+
+	bytesArray, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(bytesArray, v)
+}
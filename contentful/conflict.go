@@ -0,0 +1,123 @@
+package contentful
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// customizeVersionDiff marks the version of an existing entry or asset as
+// unknown when it is going to be updated in Contentful, since every update
+// increases it: when one of the given attributes changes, or when
+// customizePublishDiff planned a new status. Attributes that only affect
+// Terraform, like force_overwrite, leave it alone. Resources that use the
+// version, for example as a trigger, then see the new one.
+func customizeVersionDiff(d *schema.ResourceDiff, keys ...string) error {
+	if d.Id() == "" || !hasChange(d, append(keys, "status")...) {
+		return nil
+	}
+
+	return d.SetNewComputed("version")
+}
+
+// changeReporter is implemented by both schema.ResourceData and
+// schema.ResourceDiff.
+type changeReporter interface {
+	HasChange(key string) bool
+}
+
+// hasChange reports whether any of the given attributes changes.
+func hasChange(d changeReporter, keys ...string) bool {
+	for _, key := range keys {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// versionConflictError is returned when an update is rejected because the
+// object was changed in Contentful after Terraform last read it.
+type versionConflictError struct {
+	objectType    string
+	id            string
+	version       int
+	remoteVersion int
+	fields        []string
+}
+
+func (e *versionConflictError) Error() string {
+	msg := fmt.Sprintf("%s %s was changed in Contentful after it was last read: expected version %d, found version %d", e.objectType, e.id, e.version, e.remoteVersion)
+
+	if len(e.fields) > 0 {
+		msg += fmt.Sprintf("; remotely changed fields: %s", strings.Join(e.fields, ", "))
+	}
+
+	return msg + ". Refresh and review the plan, or set force_overwrite = true to overwrite the remote changes"
+}
+
+// isVersionMismatch reports whether err is, or wraps, a 409 response for an
+// outdated X-Contentful-Version.
+func isVersionMismatch(err error) bool {
+	var mismatch contentful.VersionMismatchError
+	if errors.As(err, &mismatch) {
+		return true
+	}
+
+	var response contentful.ErrorResponse
+	if errors.As(err, &response) {
+		return response.Sys != nil && (response.Sys.ID == "VersionMismatch" || response.Sys.ID == "Conflict")
+	}
+
+	return false
+}
+
+// changedLocalizedFields compares the localized values Terraform last knew
+// about with the remote ones, and returns the sorted IDs of the fields that
+// differ.
+func changedLocalizedFields(known, remote map[string]map[string]string) []string {
+	changed := map[string]bool{}
+
+	for id, values := range known {
+		for locale, value := range values {
+			if remote[id][locale] != value {
+				changed[id] = true
+			}
+		}
+	}
+
+	for id, values := range remote {
+		for locale, value := range values {
+			if known[id][locale] != value {
+				changed[id] = true
+			}
+		}
+	}
+
+	var fields []string
+	for id := range changed {
+		fields = append(fields, id)
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+// localizedString formats a remote field value the way it is kept in state.
+func localizedString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	if value == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,286 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// contentTypeDefinition is the part of a content type, as found in the
+// output of `contentful space export`, that definition_json is read from.
+type contentTypeDefinition struct {
+	DisplayField string
+	Fields       []*contentful.Field
+}
+
+// parseContentTypeDefinition reads the display field and fields of a content
+// type from its JSON representation.
+func parseContentTypeDefinition(definition string) (*contentTypeDefinition, error) {
+	var raw struct {
+		DisplayField string                   `json:"displayField"`
+		Fields       []map[string]interface{} `json:"fields"`
+	}
+
+	if err := json.Unmarshal([]byte(definition), &raw); err != nil {
+		return nil, fmt.Errorf("definition_json is not a valid content type: %s", err)
+	}
+
+	ct := &contentTypeDefinition{DisplayField: raw.DisplayField}
+	for i, rawField := range raw.Fields {
+		field, unsupported, err := decodeDefinitionField(rawField)
+		if err == nil && len(unsupported) > 0 {
+			err = fmt.Errorf("%s cannot be managed by the provider", strings.Join(unsupported, ", "))
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("field %d of definition_json: %s", i, err)
+		}
+
+		ct.Fields = append(ct.Fields, field)
+	}
+
+	if len(ct.Fields) == 0 {
+		return nil, fmt.Errorf("definition_json does not define any fields")
+	}
+
+	return ct, nil
+}
+
+// decodeDefinitionField converts a field of a content type in its JSON
+// representation to a contentful.Field. Validations are kept as they are,
+// since contentful-go drops the ones it does not know about when it decodes
+// them. The properties that contentful.Field cannot hold are returned, sorted.
+func decodeDefinitionField(raw map[string]interface{}) (*contentful.Field, []string, error) {
+	field := &contentful.Field{}
+	var unsupported []string
+
+	for key, value := range raw {
+		var err error
+
+		switch key {
+		case "id":
+			err = decodeDefinitionValue(key, value, &field.ID)
+		case "name":
+			err = decodeDefinitionValue(key, value, &field.Name)
+		case "type":
+			err = decodeDefinitionValue(key, value, &field.Type)
+		case "linkType":
+			err = decodeDefinitionValue(key, value, &field.LinkType)
+		case "required":
+			err = decodeDefinitionValue(key, value, &field.Required)
+		case "localized":
+			err = decodeDefinitionValue(key, value, &field.Localized)
+		case "disabled":
+			err = decodeDefinitionValue(key, value, &field.Disabled)
+		case "omitted":
+			err = decodeDefinitionValue(key, value, &field.Omitted)
+		case "validations":
+			field.Validations, err = decodeDefinitionValidations(key, value)
+		case "items":
+			var itemsUnsupported []string
+			field.Items, itemsUnsupported, err = decodeDefinitionItems(value)
+			for _, itemsKey := range itemsUnsupported {
+				unsupported = append(unsupported, "items."+itemsKey)
+			}
+		default:
+			unsupported = append(unsupported, key)
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	sort.Strings(unsupported)
+
+	return field, unsupported, nil
+}
+
+func decodeDefinitionItems(value interface{}) (*contentful.FieldTypeArrayItem, []string, error) {
+	raw, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("items must be an object")
+	}
+
+	items := &contentful.FieldTypeArrayItem{}
+	var unsupported []string
+
+	for key, value := range raw {
+		var err error
+
+		switch key {
+		case "type":
+			err = decodeDefinitionValue("items.type", value, &items.Type)
+		case "linkType":
+			err = decodeDefinitionValue("items.linkType", value, &items.LinkType)
+		case "validations":
+			items.Validations, err = decodeDefinitionValidations("items.validations", value)
+		default:
+			unsupported = append(unsupported, key)
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return items, unsupported, nil
+}
+
+func decodeDefinitionValue(key string, value interface{}, target interface{}) error {
+	switch target := target.(type) {
+	case *string:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be a string", key)
+		}
+
+		*target = s
+	case *bool:
+		b, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("%s must be a boolean", key)
+		}
+
+		*target = b
+	}
+
+	return nil
+}
+
+func decodeDefinitionValidations(key string, value interface{}) ([]contentful.FieldValidation, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", key)
+	}
+
+	var validations []contentful.FieldValidation
+	for _, validation := range list {
+		if _, ok := validation.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("%s must be a list of objects", key)
+		}
+
+		validations = append(validations, validation)
+	}
+
+	return validations, nil
+}
+
+// validateContentTypeDefinition is the ValidateFunc of definition_json.
+func validateContentTypeDefinition(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := parseContentTypeDefinition(v.(string)); err != nil {
+		errs = append(errs, err)
+	}
+
+	return ws, errs
+}
+
+// normalizeContentTypeDefinition is the StateFunc of definition_json. Only the
+// display field and fields are kept, in the form they are sent to Contentful,
+// so that the definition read back from Contentful compares equal and only
+// semantic changes show up in the plan.
+func normalizeContentTypeDefinition(v interface{}) string {
+	definition, err := parseContentTypeDefinition(v.(string))
+	if err != nil {
+		return v.(string)
+	}
+
+	return marshalContentTypeDefinition(definition)
+}
+
+func marshalContentTypeDefinition(definition *contentTypeDefinition) string {
+	normalized, err := json.Marshal(struct {
+		DisplayField string              `json:"displayField,omitempty"`
+		Fields       []*contentful.Field `json:"fields"`
+	}{definition.DisplayField, definition.Fields})
+	if err != nil {
+		return ""
+	}
+
+	return string(normalized)
+}
+
+// checkContentTypeDefinitionProperties rejects a name or description in
+// definition_json that differs from the attribute of the same name, since
+// only the attributes are sent to Contentful.
+func checkContentTypeDefinitionProperties(definition, name, description string) error {
+	var properties struct {
+		Name        *string `json:"name"`
+		Description *string `json:"description"`
+	}
+
+	if err := json.Unmarshal([]byte(definition), &properties); err != nil {
+		return fmt.Errorf("definition_json is not a valid content type: %s", err)
+	}
+
+	if properties.Name != nil && *properties.Name != name {
+		return fmt.Errorf("the name %q in definition_json does not match the name attribute %q", *properties.Name, name)
+	}
+
+	if properties.Description != nil && *properties.Description != description {
+		return fmt.Errorf("the description %q in definition_json does not match the description attribute %q", *properties.Description, description)
+	}
+
+	return nil
+}
+
+// definitionFieldMaps converts fields read from definition_json to the form
+// of field blocks, so they can be validated the same way.
+func definitionFieldMaps(fields []*contentful.Field) []interface{} {
+	var maps []interface{}
+
+	for _, field := range fields {
+		var items []interface{}
+		if field.Items != nil {
+			items = append(items, map[string]interface{}{
+				"type":      field.Items.Type,
+				"link_type": field.Items.LinkType,
+			})
+		}
+
+		maps = append(maps, map[string]interface{}{
+			"id":        field.ID,
+			"type":      field.Type,
+			"link_type": field.LinkType,
+			"items":     items,
+		})
+	}
+
+	return maps
+}
+
+// removedFields returns omitted copies of the old fields that are missing
+// from the new fields.
+func removedFields(old, new []*contentful.Field) []*contentful.Field {
+	ids := map[string]bool{}
+	for _, field := range new {
+		ids[field.ID] = true
+	}
+
+	var removed []*contentful.Field
+	for _, field := range old {
+		if ids[field.ID] {
+			continue
+		}
+
+		omitted := *field
+		omitted.Omitted = true
+		removed = append(removed, &omitted)
+	}
+
+	return removed
+}
+
+// hasOmittedField reports whether any of the fields is omitted.
+func hasOmittedField(fields []*contentful.Field) bool {
+	for _, field := range fields {
+		if field.Omitted {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,146 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// contentTypeTaxonomySchema is the schema of the taxonomy validations of a
+// content type, which restrict the concepts entries of the content type can
+// be tagged with to a concept scheme or to a concept and its descendants.
+func contentTypeTaxonomySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"concept_scheme_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"concept_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"required": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+// validateContentTypeTaxonomy checks that every taxonomy block links to
+// either a concept scheme or a concept.
+func validateContentTypeTaxonomy(d *schema.ResourceDiff) error {
+	for i, raw := range d.Get("taxonomy").([]interface{}) {
+		schemeKey := fmt.Sprintf("taxonomy.%d.concept_scheme_id", i)
+		conceptKey := fmt.Sprintf("taxonomy.%d.concept_id", i)
+		if !d.NewValueKnown(schemeKey) || !d.NewValueKnown(conceptKey) {
+			continue
+		}
+
+		validation := raw.(map[string]interface{})
+		if (validation["concept_scheme_id"].(string) == "") == (validation["concept_id"].(string) == "") {
+			return fmt.Errorf("taxonomy block %d must set exactly one of concept_scheme_id and concept_id", i)
+		}
+	}
+
+	return nil
+}
+
+// expandContentTypeTaxonomy converts the taxonomy blocks to the
+// metadata.taxonomy property of a content type, in its raw JSON form.
+func expandContentTypeTaxonomy(taxonomy []interface{}) []interface{} {
+	expanded := []interface{}{}
+	for _, raw := range taxonomy {
+		validation := raw.(map[string]interface{})
+
+		linkType, id := "TaxonomyConceptScheme", validation["concept_scheme_id"].(string)
+		if id == "" {
+			linkType, id = "TaxonomyConcept", validation["concept_id"].(string)
+		}
+
+		expanded = append(expanded, map[string]interface{}{
+			"sys": map[string]interface{}{
+				"type":     "Link",
+				"linkType": linkType,
+				"id":       id,
+			},
+			"required": validation["required"].(bool),
+		})
+	}
+
+	return expanded
+}
+
+// flattenContentTypeTaxonomy converts the metadata of a content type decoded
+// as raw JSON to taxonomy blocks.
+func flattenContentTypeTaxonomy(metadata map[string]interface{}) []interface{} {
+	taxonomy, _ := metadata["taxonomy"].([]interface{})
+
+	flattened := []interface{}{}
+	for _, raw := range taxonomy {
+		validation, _ := raw.(map[string]interface{})
+		sys, _ := validation["sys"].(map[string]interface{})
+		id, _ := sys["id"].(string)
+		required, _ := validation["required"].(bool)
+
+		block := map[string]interface{}{
+			"concept_scheme_id": "",
+			"concept_id":        "",
+			"required":          required,
+		}
+
+		if sys["linkType"] == "TaxonomyConcept" {
+			block["concept_id"] = id
+		} else {
+			block["concept_scheme_id"] = id
+		}
+
+		flattened = append(flattened, block)
+	}
+
+	return flattened
+}
+
+// updateContentTypeTaxonomy sets the taxonomy validations of the content type
+// saved by contentful-go, which does not know about them. The version of ct
+// is updated when they change, so it can be activated afterwards.
+func updateContentTypeTaxonomy(client *contentful.Client, spaceID, envID string, ct *contentful.ContentType, taxonomy []interface{}) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, envID, ct.Sys.ID)
+
+	var raw map[string]interface{}
+	if err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &raw); err != nil {
+		return err
+	}
+
+	metadata, _ := raw["metadata"].(map[string]interface{})
+	if reflect.DeepEqual(flattenContentTypeTaxonomy(metadata), flattenContentTypeTaxonomy(map[string]interface{}{
+		"taxonomy": expandContentTypeTaxonomy(taxonomy),
+	})) {
+		return nil
+	}
+
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+
+	metadata["taxonomy"] = expandContentTypeTaxonomy(taxonomy)
+	raw["metadata"] = metadata
+
+	var updated map[string]interface{}
+	if err := cmaRequest(client, http.MethodPut, path, nil, rawVersion(raw), raw, &updated); err != nil {
+		return err
+	}
+
+	ct.Sys.Version = rawVersion(updated)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package contentful
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+func dataSourceContentfulSpaces() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpacesRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// organizationSpace is a space as returned by the spaces collection, which
+// links every space to its organization.
+type organizationSpace struct {
+	Sys struct {
+		ID           string `json:"id"`
+		Version      int    `json:"version"`
+		CreatedAt    string `json:"createdAt"`
+		UpdatedAt    string `json:"updatedAt"`
+		Organization *link  `json:"organization"`
+	} `json:"sys"`
+	Name string `json:"name"`
+}
+
+func dataSourceSpacesRead(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	organizationID := client.Headers["X-Contentful-Organization"]
+
+	var spaces []*organizationSpace
+	if err = cmaListAll(client, "/spaces", nil, &spaces); err != nil {
+		return err
+	}
+
+	var items []map[string]interface{}
+	for _, space := range spaces {
+		// The token may have access to spaces of other organizations.
+		if space.Sys.Organization != nil && space.Sys.Organization.Sys.ID != organizationID {
+			continue
+		}
+
+		items = append(items, map[string]interface{}{
+			"id":         space.Sys.ID,
+			"name":       space.Name,
+			"version":    space.Sys.Version,
+			"created_at": space.Sys.CreatedAt,
+			"updated_at": space.Sys.UpdatedAt,
+		})
+	}
+
+	if err = d.Set("organization_id", organizationID); err != nil {
+		return err
+	}
+
+	if err = d.Set("spaces", items); err != nil {
+		return err
+	}
+
+	d.SetId(organizationID)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContentfulSpacesDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulSpacesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_spaces.all", "organization_id", orgID),
+					resource.TestCheckResourceAttrSet("data.contentful_spaces.all", "spaces.0.id"),
+					resource.TestCheckResourceAttrSet("data.contentful_spaces.all", "spaces.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulSpacesDataSource_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: fake.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulSpacesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_spaces.all", "organization_id", fakeOrgID),
+					resource.TestCheckResourceAttr("data.contentful_spaces.all", "spaces.#", "1"),
+					resource.TestCheckResourceAttr("data.contentful_spaces.all", "spaces.0.id", fakeSpaceID),
+				),
+			},
+		},
+	})
+}
+
+var testAccContentfulSpacesDataSourceConfig = `
+data "contentful_spaces" "all" {}
+`
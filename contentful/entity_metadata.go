@@ -0,0 +1,133 @@
+package contentful
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// metadataLinkTypes maps the lists of links in the metadata of entries and
+// assets to the type of object they link to. The attributes managing them
+// have the same names.
+var metadataLinkTypes = map[string]string{
+	"tags":     "Tag",
+	"concepts": "TaxonomyConcept",
+}
+
+// metadataLinksSchema is the schema of an attribute holding the IDs of the
+// objects linked from a list in the metadata of an entry or asset.
+func metadataLinksSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// metadataLinkIDs returns the sorted IDs of the objects linked from the
+// metadata list key of an entry or asset decoded as raw JSON.
+func metadataLinkIDs(entity map[string]interface{}, key string) []string {
+	metadata, _ := entity["metadata"].(map[string]interface{})
+	links, _ := metadata[key].([]interface{})
+
+	ids := []string{}
+	for _, rawLink := range links {
+		link, _ := rawLink.(map[string]interface{})
+		sys, _ := link["sys"].(map[string]interface{})
+		if id, ok := sys["id"].(string); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+// updateEntityMetadata sets the metadata lists of the entry or asset at path
+// to links to the given IDs, leaving its fields untouched. Nothing is written
+// when the lists are already as requested, so the version only increases when
+// they change.
+func updateEntityMetadata(client *contentful.Client, path string, ids map[string][]string) error {
+	var entity map[string]interface{}
+	if err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &entity); err != nil {
+		return err
+	}
+
+	metadata, _ := entity["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+
+	changed := false
+	for key, keyIDs := range ids {
+		sort.Strings(keyIDs)
+		if equalStrings(metadataLinkIDs(entity, key), keyIDs) {
+			continue
+		}
+
+		links := []*link{}
+		for _, id := range keyIDs {
+			links = append(links, newLink(metadataLinkTypes[key], id))
+		}
+
+		metadata[key] = links
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	entity["metadata"] = metadata
+
+	return cmaRequest(client, http.MethodPut, path, nil, rawVersion(entity), entity, nil)
+}
+
+// setEntityMetadata stores the metadata lists of the entry or asset at path in
+// the attributes of the same name, so changes made in the web app show up as
+// drift.
+func setEntityMetadata(d *schema.ResourceData, client *contentful.Client, path string, keys ...string) error {
+	var entity map[string]interface{}
+	if err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &entity); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := d.Set(key, metadataLinkIDs(entity, key)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// metadataLinksConfig returns the IDs configured for each of the metadata
+// lists keys.
+func metadataLinksConfig(d *schema.ResourceData, keys ...string) map[string][]string {
+	ids := map[string][]string{}
+	for _, key := range keys {
+		ids[key] = []string{}
+		for _, id := range d.Get(key).(*schema.Set).List() {
+			ids[key] = append(ids[key], id.(string))
+		}
+	}
+
+	return ids
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,157 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+)
+
+// entryIDPattern matches the IDs Contentful accepts for entries.
+var entryIDPattern = regexp.MustCompile(`^[a-zA-Z0-9\-_.]{1,64}$`)
+
+// documentEntry is an entry of the document_json of contentful_entries. Field
+// values are kept as in the Content Management API, by field ID and locale,
+// so links are given as link objects.
+type documentEntry struct {
+	ContentType string                            `json:"content_type"`
+	Fields      map[string]map[string]interface{} `json:"fields,omitempty"`
+}
+
+// parseEntriesDocument reads document_json, an object of entries keyed by
+// entry ID.
+func parseEntriesDocument(document string) (map[string]*documentEntry, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return nil, fmt.Errorf("document_json is not an object of entries by ID: %s", err)
+	}
+
+	var ids []string
+	for id := range raw {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	entries := map[string]*documentEntry{}
+	for _, id := range ids {
+		if !entryIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("entry ID %q of document_json must be 1 to 64 letters, digits, dots, hyphens or underscores", id)
+		}
+
+		var entry documentEntry
+		if err := json.Unmarshal(raw[id], &entry); err != nil {
+			return nil, fmt.Errorf("entry %s of document_json must have a content_type and fields by ID and locale: %s", id, err)
+		}
+
+		if entry.ContentType == "" {
+			return nil, fmt.Errorf("entry %s of document_json has no content_type", id)
+		}
+
+		dropEmptyFields(&entry)
+		entries[id] = &entry
+	}
+
+	return entries, nil
+}
+
+func validateEntriesDocument(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := parseEntriesDocument(v.(string)); err != nil {
+		errs = append(errs, err)
+	}
+
+	return ws, errs
+}
+
+// normalizeEntriesDocument is the StateFunc of document_json. Keys are sorted
+// and fields without values are dropped, so that only semantic changes show up
+// in the plan and the document read from Contentful compares equal.
+func normalizeEntriesDocument(v interface{}) string {
+	entries, err := parseEntriesDocument(v.(string))
+	if err != nil {
+		return v.(string)
+	}
+
+	return marshalEntriesDocument(entries)
+}
+
+func marshalEntriesDocument(entries map[string]*documentEntry) string {
+	normalized, err := json.Marshal(entries)
+	if err != nil {
+		return ""
+	}
+
+	return string(normalized)
+}
+
+// changedDocumentEntries compares two documents and returns the sorted IDs of
+// the entries to create, to update and to delete. An entry that moves to
+// another content type is deleted and created again, since Contentful does not
+// allow changing the content type of an entry.
+func changedDocumentEntries(old, new map[string]*documentEntry) (created, updated, deleted []string) {
+	for id, entry := range new {
+		previous, ok := old[id]
+		switch {
+		case !ok:
+			created = append(created, id)
+		case previous.ContentType != entry.ContentType:
+			deleted = append(deleted, id)
+			created = append(created, id)
+		case !reflect.DeepEqual(previous.Fields, entry.Fields):
+			updated = append(updated, id)
+		}
+	}
+
+	for id := range old {
+		if _, ok := new[id]; !ok {
+			deleted = append(deleted, id)
+		}
+	}
+
+	sort.Strings(created)
+	sort.Strings(updated)
+	sort.Strings(deleted)
+
+	return created, updated, deleted
+}
+
+// dropEmptyFields removes the fields without any localized value, which
+// Contentful does not return.
+func dropEmptyFields(entry *documentEntry) {
+	for id, values := range entry.Fields {
+		if len(values) == 0 {
+			delete(entry.Fields, id)
+		}
+	}
+
+	if len(entry.Fields) == 0 {
+		entry.Fields = nil
+	}
+}
+
+// documentFieldValues formats the field values of an entry the way
+// changedLocalizedFields compares them.
+func documentFieldValues(fields map[string]map[string]interface{}) map[string]map[string]string {
+	values := map[string]map[string]string{}
+
+	for id, localized := range fields {
+		values[id] = map[string]string{}
+		for locale, value := range localized {
+			values[id][locale] = localizedString(value)
+		}
+	}
+
+	return values
+}
+
+func sortedDocumentIDs(entries map[string]*documentEntry) []string {
+	var ids []string
+	for id := range entries {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
@@ -0,0 +1,106 @@
+package contentful
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseEntriesDocument(t *testing.T) {
+	cases := []struct {
+		name     string
+		document string
+		// err is a prefix of the expected error, since the messages of
+		// encoding/json differ between Go versions.
+		err string
+	}{
+		{
+			name:     "valid",
+			document: `{"germany": {"content_type": "country", "fields": {"name": {"en-US": "Germany"}}}}`,
+		},
+		{
+			name:     "not an object",
+			document: `[]`,
+			err:      "document_json is not an object of entries by ID: ",
+		},
+		{
+			name:     "invalid ID",
+			document: `{"not valid": {"content_type": "country"}}`,
+			err:      `entry ID "not valid" of document_json must be 1 to 64 letters, digits, dots, hyphens or underscores`,
+		},
+		{
+			name:     "missing content type",
+			document: `{"germany": {"fields": {}}}`,
+			err:      "entry germany of document_json has no content_type",
+		},
+		{
+			name:     "fields not localized",
+			document: `{"germany": {"content_type": "country", "fields": {"name": "Germany"}}}`,
+			err:      "entry germany of document_json must have a content_type and fields by ID and locale: ",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := parseEntriesDocument(c.document)
+
+			switch {
+			case c.err == "" && err != nil:
+				t.Errorf("unexpected error: %s", err)
+			case c.err != "" && (err == nil || !strings.HasPrefix(err.Error(), c.err)):
+				t.Errorf("expected error %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeEntriesDocument(t *testing.T) {
+	document := `{
+  "germany": {
+    "fields": {"name": {"en-US": "Germany"}, "code": {}},
+    "content_type": "country"
+  },
+  "europe": {"content_type": "region", "fields": {}}
+}`
+
+	expected := `{"europe":{"content_type":"region"},"germany":{"content_type":"country","fields":{"name":{"en-US":"Germany"}}}}`
+	if normalized := normalizeEntriesDocument(document); normalized != expected {
+		t.Errorf("expected %s, got %s", expected, normalized)
+	}
+}
+
+func TestChangedDocumentEntries(t *testing.T) {
+	old, err := parseEntriesDocument(`{
+  "unchanged": {"content_type": "country", "fields": {"name": {"en-US": "Germany"}}},
+  "updated": {"content_type": "country", "fields": {"name": {"en-US": "France"}}},
+  "moved": {"content_type": "country", "fields": {"name": {"en-US": "Europe"}}},
+  "deleted": {"content_type": "country", "fields": {"name": {"en-US": "Prussia"}}}
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := parseEntriesDocument(`{
+  "unchanged": {"content_type": "country", "fields": {"name": {"en-US": "Germany"}}},
+  "updated": {"content_type": "country", "fields": {"name": {"en-US": "France", "fr-FR": "France"}}},
+  "moved": {"content_type": "region", "fields": {"name": {"en-US": "Europe"}}},
+  "created": {"content_type": "country", "fields": {"name": {"en-US": "Spain"}}}
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, updated, deleted := changedDocumentEntries(old, entries)
+
+	if expected := []string{"created", "moved"}; !reflect.DeepEqual(created, expected) {
+		t.Errorf("expected created %v, got %v", expected, created)
+	}
+
+	if expected := []string{"updated"}; !reflect.DeepEqual(updated, expected) {
+		t.Errorf("expected updated %v, got %v", expected, updated)
+	}
+
+	if expected := []string{"deleted", "moved"}; !reflect.DeepEqual(deleted, expected) {
+		t.Errorf("expected deleted %v, got %v", expected, deleted)
+	}
+}
@@ -0,0 +1,155 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// entryFieldTypes are the content type field types whose values can be given
+// as the string content of an entry field.
+var entryFieldTypes = map[string]bool{
+	"Symbol": true,
+	"Text":   true,
+	"Date":   true,
+}
+
+// entryFieldValue is a field block of an entry whose id and locale are known
+// at plan time.
+type entryFieldValue struct {
+	ID     string
+	Locale string
+}
+
+// customizeEntryFieldsDiff checks the field blocks of an entry against its
+// content type and the locales of its environment, so mistakes show up in the
+// plan instead of failing the apply. Nothing is checked while the content
+// type is unknown or does not exist yet, which is the case when it is created
+// in the same apply. Fields are not required to exist when the content type
+// is changed in the same apply, nor are locales that are created by it.
+func customizeEntryFieldsDiff(d *schema.ResourceDiff, client *contentful.Client) error {
+	for _, key := range []string{"space_id", "env_id", "contenttype_id"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	spaceID := d.Get("space_id").(string)
+	envID := d.Get("env_id").(string)
+	contentTypeID := d.Get("contenttype_id").(string)
+
+	var ct contentful.ContentType
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, envID, contentTypeID)
+	err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &ct)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var locales []*contentful.Locale
+	if err := cmaListAll(client, fmt.Sprintf("/spaces/%s/environments/%s/locales", spaceID, envID), nil, &locales); err != nil {
+		return err
+	}
+
+	var fields []entryFieldValue
+	complete := true
+	for i, raw := range d.Get("field").([]interface{}) {
+		if !d.NewValueKnown(fmt.Sprintf("field.%d.id", i)) || !d.NewValueKnown(fmt.Sprintf("field.%d.locale", i)) {
+			complete = false
+			continue
+		}
+
+		field := raw.(map[string]interface{})
+		fields = append(fields, entryFieldValue{
+			ID:     field["id"].(string),
+			Locale: field["locale"].(string),
+		})
+	}
+
+	// A field block that is not known yet may provide a required field. The
+	// entry is only published by this resource when it manages its state.
+	published := complete && d.Get("manage_publish_state").(bool) && d.Get("published").(bool)
+
+	localePlanned := func(code string) bool {
+		return isChangePlanned(client, localeCodePath(spaceID, code))
+	}
+
+	return validateEntryFields(&ct, locales, fields, published, isChangePlanned(client, path), localePlanned)
+}
+
+// validateEntryFields returns an error listing every field that does not
+// match the content type: unknown fields, fields that cannot hold string
+// content, values for locales that do not exist or for other locales than the
+// default one on fields that are not localized and, when the entry is
+// published, required fields without a value for the default locale. Unknown
+// and required fields are not checked when contentTypePlanned is set, since
+// the fields of the content type are going to change, and locales for which
+// localePlanned returns true are going to be created.
+func validateEntryFields(ct *contentful.ContentType, locales []*contentful.Locale, fields []entryFieldValue, published, contentTypePlanned bool, localePlanned func(code string) bool) error {
+	contentTypeFields := map[string]*contentful.Field{}
+	for _, field := range ct.Fields {
+		contentTypeFields[field.ID] = field
+	}
+
+	codes := map[string]bool{}
+	defaultLocale := ""
+	for _, locale := range locales {
+		codes[locale.Code] = true
+		if locale.Default {
+			defaultLocale = locale.Code
+		}
+	}
+
+	var problems []string
+	provided := map[string]bool{}
+	for _, value := range fields {
+		field, ok := contentTypeFields[value.ID]
+		if !ok {
+			if !contentTypePlanned {
+				problems = append(problems, fmt.Sprintf("field %s does not exist", value.ID))
+			}
+
+			continue
+		}
+
+		if !entryFieldTypes[field.Type] {
+			problems = append(problems, fmt.Sprintf("field %s is of type %s, which cannot be set from string content", value.ID, field.Type))
+		}
+
+		if len(codes) > 0 && !codes[value.Locale] && !localePlanned(value.Locale) {
+			problems = append(problems, fmt.Sprintf("locale %s of field %s does not exist in the environment", value.Locale, value.ID))
+			continue
+		}
+
+		if !field.Localized && defaultLocale != "" && value.Locale != defaultLocale {
+			problems = append(problems, fmt.Sprintf("field %s is not localized and can only have a value for the default locale %s, not %s", value.ID, defaultLocale, value.Locale))
+		}
+
+		if value.Locale == defaultLocale {
+			provided[value.ID] = true
+		}
+	}
+
+	if published && !contentTypePlanned && defaultLocale != "" {
+		for _, field := range ct.Fields {
+			if field.Required && !field.Disabled && !field.Omitted && !provided[field.ID] {
+				problems = append(problems, fmt.Sprintf("field %s is required to publish the entry, but has no value for the default locale %s", field.ID, defaultLocale))
+			}
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+
+	return fmt.Errorf("entry does not match content type %s:\n  - %s", ct.Sys.ID, strings.Join(problems, "\n  - "))
+}
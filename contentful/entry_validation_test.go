@@ -0,0 +1,63 @@
+package contentful
+
+import (
+	"strings"
+	"testing"
+
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+func TestValidateEntryFields(t *testing.T) {
+	ct := &contentful.ContentType{
+		Sys: &contentful.Sys{ID: "article"},
+		Fields: []*contentful.Field{
+			{ID: "title", Type: "Symbol", Required: true, Localized: true},
+			{ID: "slug", Type: "Symbol", Required: true},
+			{ID: "views", Type: "Integer"},
+			{ID: "legacy", Type: "Text", Required: true, Omitted: true},
+		},
+	}
+
+	locales := []*contentful.Locale{
+		{Code: "en-US", Default: true},
+		{Code: "de-DE"},
+	}
+
+	cases := []struct {
+		name               string
+		fields             []entryFieldValue
+		published          bool
+		contentTypePlanned bool
+		plannedLocale      string
+		problem            string
+	}{
+		{"valid", []entryFieldValue{{"title", "en-US"}, {"title", "de-DE"}, {"slug", "en-US"}}, true, false, "", ""},
+		{"draft without required fields", []entryFieldValue{{"title", "de-DE"}}, false, false, "", ""},
+		{"unknown field", []entryFieldValue{{"titel", "en-US"}}, false, false, "", "field titel does not exist"},
+		{"field added in the same apply", []entryFieldValue{{"summary", "en-US"}}, true, true, "", ""},
+		{"wrong type", []entryFieldValue{{"views", "en-US"}}, false, false, "", "field views is of type Integer"},
+		{"unknown locale", []entryFieldValue{{"title", "fr-FR"}}, false, false, "", "locale fr-FR of field title does not exist"},
+		{"locale added in the same apply", []entryFieldValue{{"title", "fr-FR"}}, false, false, "fr-FR", ""},
+		{"not localized", []entryFieldValue{{"slug", "de-DE"}}, false, false, "", "field slug is not localized"},
+		{"missing required", []entryFieldValue{{"title", "en-US"}}, true, false, "", "field slug is required"},
+	}
+
+	for _, c := range cases {
+		plannedLocale := c.plannedLocale
+		err := validateEntryFields(ct, locales, c.fields, c.published, c.contentTypePlanned, func(code string) bool {
+			return code == plannedLocale
+		})
+
+		if c.problem == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", c.name, err)
+			}
+
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), c.problem) {
+			t.Errorf("%s: expected an error containing %q, got %v", c.name, c.problem, err)
+		}
+	}
+}
@@ -0,0 +1,851 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+const (
+	fakeSpaceID = "fake-space"
+	fakeOrgID   = "fake-organization"
+)
+
+// fakeCollections maps the collection segments of the Content Management API
+// paths to the sys.type of the objects stored in them.
+var fakeCollections = map[string]string{
+	"spaces":              "Space",
+	"environments":        "Environment",
+	"environment_aliases": "EnvironmentAlias",
+	"content_types":       "ContentType",
+	"entries":             "Entry",
+	"assets":              "Asset",
+	"locales":             "Locale",
+	"webhook_definitions": "WebhookDefinition",
+	"api_keys":            "ApiKey",
+	"preview_api_keys":    "PreviewApiKey",
+	"tags":                "Tag",
+	"concepts":            "TaxonomyConcept",
+	"concept-schemes":     "TaxonomyConceptScheme",
+	"scheduled_actions":   "ScheduledAction",
+	"releases":            "Release",
+	"actions":             "ReleaseAction",
+}
+
+// fakeCMA is an in-memory implementation of the parts of the Content
+// Management API used by the provider. Objects are stored by path and carry
+// a sys.version that is checked against X-Contentful-Version on every write,
+// so optimistic locking behaves like the real API.
+type fakeCMA struct {
+	server  *httptest.Server
+	mu      sync.Mutex
+	objects map[string]map[string]interface{}
+	counter int
+
+	// pending holds the outcome of asynchronous actions, which they reach
+	// when they are polled for the first time.
+	pending map[string]map[string]interface{}
+}
+
+// newFakeCMA starts a fake Content Management API with a single space that
+// has a master environment and an en-US default locale.
+func newFakeCMA(t *testing.T) *fakeCMA {
+	f := &fakeCMA{
+		objects: map[string]map[string]interface{}{},
+		pending: map[string]map[string]interface{}{},
+	}
+
+	f.server = httptest.NewServer(f)
+	t.Cleanup(f.server.Close)
+
+	f.create("/spaces", fakeSpaceID, map[string]interface{}{
+		"name": "Fake space",
+	}, nil)
+
+	return f
+}
+
+// client returns a contentful-go client talking to the fake.
+func (f *fakeCMA) client() *contentful.Client {
+	client := contentful.NewCMA("fake-token")
+	client.SetOrganization(fakeOrgID)
+	client.BaseURL = f.server.URL
+
+	return client
+}
+
+// providers returns a provider configured against the fake, for use with
+// resource.UnitTest.
+func (f *fakeCMA) providers() map[string]terraform.ResourceProvider {
+	provider := Provider().(*schema.Provider)
+
+	for _, key := range []string{"cma_token", "organization_id"} {
+		provider.Schema[key].Required = false
+		provider.Schema[key].Optional = true
+		provider.Schema[key].DefaultFunc = nil
+	}
+
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return f.client(), nil
+	}
+
+	return map[string]terraform.ResourceProvider{
+		"contentful": provider,
+	}
+}
+
+// get returns a copy of the object stored at path, or nil.
+func (f *fakeCMA) get(path string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	object, ok := f.objects[path]
+	if !ok {
+		return nil
+	}
+
+	return copyFakeObject(object)
+}
+
+// put stores object at path, bypassing version checks. It is used by tests to
+// simulate changes made outside of Terraform.
+func (f *fakeCMA) put(path string, object map[string]interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.objects[path] = copyFakeObject(object)
+}
+
+func (f *fakeCMA) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var body map[string]interface{}
+	if r.Body != nil && (r.Method == http.MethodPost || r.Method == http.MethodPut) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	if r.Method == http.MethodPatch {
+		f.servePatch(w, r, strings.TrimRight(r.URL.Path, "/"))
+		return
+	}
+
+	path := strings.TrimRight(r.URL.Path, "/")
+
+	path = fakeMasterPath(path)
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	last := segments[len(segments)-1]
+
+	switch {
+	case last == "published" || last == "archived":
+		f.serveState(w, r, strings.TrimSuffix(path, "/"+last), last)
+	case len(segments) > 1 && segments[len(segments)-2] == "bulk_actions" && r.Method == http.MethodPost:
+		f.serveBulkAction(w, strings.TrimSuffix(path, "/bulk_actions/"+last), last, body)
+	case last == "validate" && r.Method == http.MethodPost:
+		f.serveReleaseAction(w, strings.TrimSuffix(path, "/"+last), "validate")
+	case last == "process" && len(segments) > 3 && segments[len(segments)-3] == "files":
+		f.serveProcess(w, r, strings.Join(segments[:len(segments)-3], "/"))
+	case fakeCollections[last] != "":
+		f.serveCollection(w, r, path, body)
+	default:
+		f.serveObject(w, r, path, body)
+	}
+}
+
+func (f *fakeCMA) serveCollection(w http.ResponseWriter, r *http.Request, path string, body map[string]interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		var items []interface{}
+		for _, key := range f.sortedPaths() {
+			if strings.HasPrefix(key, path+"/") && !strings.Contains(strings.TrimPrefix(key, path+"/"), "/") && fakeQueryMatches(f.objects[key], r.URL.Query()) {
+				items = append(items, f.objects[key])
+			}
+		}
+
+		total := len(items)
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil || limit == 0 {
+			limit = 100
+		}
+
+		if skip > total {
+			skip = total
+		}
+
+		end := skip + limit
+		if end > total {
+			end = total
+		}
+
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{
+			"sys":   map[string]interface{}{"type": "Array"},
+			"total": total,
+			"skip":  skip,
+			"limit": limit,
+			"items": items[skip:end],
+		})
+	case http.MethodPost:
+		object := f.create(path, f.nextID(), body, r)
+		writeFakeJSON(w, http.StatusCreated, object)
+	default:
+		writeFakeError(w, http.StatusMethodNotAllowed, "BadRequest", "method not allowed")
+	}
+}
+
+func (f *fakeCMA) serveObject(w http.ResponseWriter, r *http.Request, path string, body map[string]interface{}) {
+	object, exists := f.objects[path]
+
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeFakeError(w, http.StatusNotFound, "NotFound", "The resource could not be found.")
+			return
+		}
+
+		if outcome, ok := f.pending[path]; ok {
+			for key, value := range outcome {
+				object[key] = value
+			}
+
+			fakeSys(object)["status"] = outcome["status"]
+			delete(object, "status")
+			delete(f.pending, path)
+		}
+
+		writeFakeJSON(w, http.StatusOK, object)
+	case http.MethodPut:
+		if !exists {
+			index := strings.LastIndex(path, "/")
+			object = f.create(path[:index], path[index+1:], body, r)
+			writeFakeJSON(w, http.StatusCreated, object)
+			return
+		}
+
+		if !f.checkVersion(w, r, object) {
+			return
+		}
+
+		sys := fakeSys(object)
+		updated := map[string]interface{}{}
+		for key, value := range body {
+			updated[key] = value
+		}
+
+		// Tokens of API keys are generated by the API and survive updates.
+		if sys["type"] == "ApiKey" {
+			for _, key := range []string{"accessToken", "preview_api_key", "environments"} {
+				if updated[key] == nil {
+					updated[key] = object[key]
+				}
+			}
+		}
+
+		if sys["type"] == "ContentType" {
+			f.renameFields(path, updated)
+		}
+
+		updated["sys"] = sys
+		sys["version"] = fakeVersion(object) + 1
+		sys["updatedAt"] = f.now()
+		f.objects[path] = updated
+
+		writeFakeJSON(w, http.StatusOK, updated)
+	case http.MethodDelete:
+		if !exists {
+			writeFakeError(w, http.StatusNotFound, "NotFound", "The resource could not be found.")
+			return
+		}
+
+		if !f.checkVersion(w, r, object) {
+			return
+		}
+
+		// Scheduled actions are canceled rather than deleted.
+		if fakeSys(object)["type"] == "ScheduledAction" {
+			fakeSys(object)["status"] = "canceled"
+			writeFakeJSON(w, http.StatusOK, object)
+			return
+		}
+
+		if fakeSys(object)["publishedVersion"] != nil {
+			writeFakeError(w, http.StatusBadRequest, "BadRequest", "Cannot delete published or active objects")
+			return
+		}
+
+		for key := range f.objects {
+			if key == path || strings.HasPrefix(key, path+"/") {
+				delete(f.objects, key)
+			}
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeError(w, http.StatusMethodNotAllowed, "BadRequest", "method not allowed")
+	}
+}
+
+// servePatch applies a JSON Patch to an object. Only operations on top-level
+// properties are supported.
+func (f *fakeCMA) servePatch(w http.ResponseWriter, r *http.Request, path string) {
+	object, exists := f.objects[path]
+	if !exists {
+		writeFakeError(w, http.StatusNotFound, "NotFound", "The resource could not be found.")
+		return
+	}
+
+	if r.Header.Get("Content-Type") != "application/json-patch+json" {
+		writeFakeError(w, http.StatusUnsupportedMediaType, "BadRequest", "expected a JSON Patch")
+		return
+	}
+
+	if !f.checkVersion(w, r, object) {
+		return
+	}
+
+	var operations []map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&operations); err != nil {
+		writeFakeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	for _, operation := range operations {
+		key := strings.TrimPrefix(operation["path"].(string), "/")
+
+		switch operation["op"] {
+		case "add", "replace":
+			object[key] = operation["value"]
+		case "remove":
+			delete(object, key)
+		default:
+			writeFakeError(w, http.StatusBadRequest, "BadRequest", "unsupported operation")
+			return
+		}
+	}
+
+	sys := fakeSys(object)
+	sys["version"] = fakeVersion(object) + 1
+	sys["updatedAt"] = f.now()
+
+	writeFakeJSON(w, http.StatusOK, object)
+}
+
+// serveState handles publishing, activating and archiving of an object.
+func (f *fakeCMA) serveState(w http.ResponseWriter, r *http.Request, path, state string) {
+	object, exists := f.objects[path]
+	if !exists {
+		writeFakeError(w, http.StatusNotFound, "NotFound", "The resource could not be found.")
+		return
+	}
+
+	if !f.checkVersion(w, r, object) {
+		return
+	}
+
+	if fakeSys(object)["type"] == "Release" && state == "published" {
+		action := "publish"
+		if r.Method == http.MethodDelete {
+			action = "unpublish"
+		}
+
+		f.serveReleaseAction(w, path, action)
+		return
+	}
+
+	if status, message := f.changeState(object, state, r.Method); status != 0 {
+		writeFakeError(w, status, "BadRequest", message)
+		return
+	}
+
+	writeFakeJSON(w, http.StatusOK, object)
+}
+
+// changeState publishes, unpublishes, archives or unarchives an object. It
+// returns the HTTP status and message of the error when the transition is
+// not allowed.
+func (f *fakeCMA) changeState(object map[string]interface{}, state, method string) (int, string) {
+	sys := fakeSys(object)
+	version := fakeVersion(object)
+	now := f.now()
+
+	switch {
+	case state == "published" && method == http.MethodPut:
+		if sys["archivedVersion"] != nil {
+			return http.StatusBadRequest, "Cannot publish archived"
+		}
+
+		sys["publishedVersion"] = version
+		sys["publishedAt"] = now
+		sys["publishedCounter"] = fakeInt(sys["publishedCounter"]) + 1
+		if sys["firstPublishedAt"] == nil {
+			sys["firstPublishedAt"] = now
+		}
+	case state == "published" && method == http.MethodDelete:
+		if sys["publishedVersion"] == nil {
+			return http.StatusBadRequest, "Not published"
+		}
+
+		delete(sys, "publishedVersion")
+		delete(sys, "publishedAt")
+	case state == "archived" && method == http.MethodPut:
+		if sys["publishedVersion"] != nil {
+			return http.StatusBadRequest, "Cannot archive published"
+		}
+
+		sys["archivedVersion"] = version
+		sys["archivedAt"] = now
+	case state == "archived" && method == http.MethodDelete:
+		if sys["archivedVersion"] == nil {
+			return http.StatusBadRequest, "Not archived"
+		}
+
+		delete(sys, "archivedVersion")
+		delete(sys, "archivedAt")
+	default:
+		return http.StatusMethodNotAllowed, "method not allowed"
+	}
+
+	sys["version"] = version + 1
+	sys["updatedAt"] = now
+
+	return 0, ""
+}
+
+// serveReleaseAction starts a publish, unpublish or validate action for the
+// entities of the release at path. Like the real API, the action runs
+// asynchronously and touches no entity unless all of them can be handled.
+func (f *fakeCMA) serveReleaseAction(w http.ResponseWriter, path, action string) {
+	release, exists := f.objects[path]
+	if !exists {
+		writeFakeError(w, http.StatusNotFound, "NotFound", "The resource could not be found.")
+		return
+	}
+
+	entities, _ := release["entities"].(map[string]interface{})
+	links, _ := entities["items"].([]interface{})
+	environmentPath := path[:strings.Index(path, "/releases/")]
+
+	object := f.create(path+"/actions", f.nextID(), map[string]interface{}{
+		"action": action,
+	}, nil)
+	fakeSys(object)["status"] = "inProgress"
+
+	f.pending[path+"/actions/"+fakeSys(object)["id"].(string)] = f.runEntityAction(environmentPath, action, links)
+
+	writeFakeJSON(w, http.StatusAccepted, object)
+}
+
+// serveBulkAction starts a publish, unpublish or validate bulk action for the
+// entities in the body.
+func (f *fakeCMA) serveBulkAction(w http.ResponseWriter, environmentPath, action string, body map[string]interface{}) {
+	entities, _ := body["entities"].(map[string]interface{})
+	links, _ := entities["items"].([]interface{})
+
+	object := f.create(environmentPath+"/bulk_actions/actions", f.nextID(), map[string]interface{}{
+		"action": action,
+	}, nil)
+	fakeSys(object)["type"] = "BulkAction"
+	fakeSys(object)["status"] = "inProgress"
+
+	f.pending[environmentPath+"/bulk_actions/actions/"+fakeSys(object)["id"].(string)] = f.runEntityAction(environmentPath, action, links)
+
+	writeFakeJSON(w, http.StatusCreated, object)
+}
+
+// runEntityAction publishes, unpublishes or validates the linked entries and
+// assets of an environment, and returns the outcome of the action.
+func (f *fakeCMA) runEntityAction(environmentPath, action string, links []interface{}) map[string]interface{} {
+	collections := map[string]string{"Entry": "entries", "Asset": "assets"}
+
+	var objects []map[string]interface{}
+	var errors []interface{}
+	for _, rawLink := range links {
+		link, _ := rawLink.(map[string]interface{})
+		sys, _ := link["sys"].(map[string]interface{})
+		linkType, _ := sys["linkType"].(string)
+		id, _ := sys["id"].(string)
+
+		object, exists := f.objects[fakeMasterPath(fmt.Sprintf("%s/%s/%s", environmentPath, collections[linkType], id))]
+		if !exists {
+			errors = append(errors, map[string]interface{}{
+				"entity": fakeLink(linkType, id),
+				"error": map[string]interface{}{
+					"sys":     map[string]interface{}{"type": "Error", "id": "NotFound"},
+					"message": "The resource could not be found.",
+				},
+			})
+
+			continue
+		}
+
+		if version, ok := sys["version"]; ok && fakeInt(version) != fakeVersion(object) {
+			errors = append(errors, map[string]interface{}{
+				"entity": fakeLink(linkType, id),
+				"error": map[string]interface{}{
+					"sys":     map[string]interface{}{"type": "Error", "id": "VersionMismatch"},
+					"message": "Version mismatch",
+				},
+			})
+
+			continue
+		}
+
+		objects = append(objects, object)
+	}
+
+	if len(errors) > 0 {
+		return map[string]interface{}{
+			"status": "failed",
+			"error": map[string]interface{}{
+				"sys":     map[string]interface{}{"type": "Error", "id": "BadRequest"},
+				"message": fmt.Sprintf("%d entities could not be handled", len(errors)),
+				"details": map[string]interface{}{"errors": errors},
+			},
+		}
+	}
+
+	for _, object := range objects {
+		switch action {
+		case "publish":
+			f.changeState(object, "published", http.MethodPut)
+		case "unpublish":
+			f.changeState(object, "published", http.MethodDelete)
+		}
+	}
+
+	return map[string]interface{}{"status": "succeeded"}
+}
+
+// fakeMasterPath maps the paths of assets and locales in the master
+// environment to the paths without an environment, which is how the real API
+// treats them and how contentful-go requests them.
+func fakeMasterPath(path string) string {
+	for _, collection := range []string{"assets", "locales"} {
+		prefix := "/environments/master/" + collection
+		if strings.HasSuffix(path, prefix) || strings.Contains(path, prefix+"/") {
+			return strings.Replace(path, prefix, "/"+collection, 1)
+		}
+	}
+
+	return path
+}
+
+// serveProcess fakes the asset processing by turning upload URLs into file
+// URLs.
+func (f *fakeCMA) serveProcess(w http.ResponseWriter, r *http.Request, path string) {
+	object, exists := f.objects["/"+path]
+	if !exists {
+		writeFakeError(w, http.StatusNotFound, "NotFound", "The resource could not be found.")
+		return
+	}
+
+	if !f.checkVersion(w, r, object) {
+		return
+	}
+
+	if fields, ok := object["fields"].(map[string]interface{}); ok {
+		if files, ok := fields["file"].(map[string]interface{}); ok {
+			for _, file := range files {
+				if file, ok := file.(map[string]interface{}); ok && file["upload"] != nil {
+					file["url"] = file["upload"]
+					delete(file, "upload")
+				}
+			}
+		}
+	}
+
+	sys := fakeSys(object)
+	sys["version"] = fakeVersion(object) + 1
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// create stores a new object with the given ID in the collection at path and
+// fills in its sys properties.
+func (f *fakeCMA) create(collectionPath, id string, body map[string]interface{}, r *http.Request) map[string]interface{} {
+	segments := strings.Split(strings.Trim(collectionPath, "/"), "/")
+	collection := segments[len(segments)-1]
+	now := f.now()
+
+	object := map[string]interface{}{}
+	for key, value := range body {
+		object[key] = value
+	}
+
+	sys := map[string]interface{}{
+		"id":        id,
+		"type":      fakeCollections[collection],
+		"version":   1,
+		"createdAt": now,
+		"updatedAt": now,
+	}
+	object["sys"] = sys
+
+	switch {
+	case segments[0] == "organizations":
+		sys["organization"] = fakeLink("Organization", segments[1])
+	case len(segments) > 1:
+		sys["space"] = fakeLink("Space", segments[1])
+	default:
+		sys["organization"] = fakeLink("Organization", fakeOrgID)
+	}
+
+	if len(segments) > 3 && segments[2] == "environments" {
+		sys["environment"] = fakeLink("Environment", segments[3])
+	}
+
+	path := collectionPath + "/" + id
+	f.objects[path] = object
+
+	switch collection {
+	case "environments":
+		sys["status"] = fakeLink("Status", "ready")
+	case "spaces":
+		defaultLocale, _ := body["defaultLocale"].(string)
+		if defaultLocale == "" {
+			defaultLocale = "en-US"
+		}
+
+		delete(object, "defaultLocale")
+
+		f.create(path+"/environments", "master", map[string]interface{}{"name": "master"}, nil)
+		f.create(path+"/locales", f.nextID(), map[string]interface{}{
+			"name":                 defaultLocale,
+			"code":                 defaultLocale,
+			"default":              true,
+			"contentDeliveryApi":   true,
+			"contentManagementApi": true,
+		}, nil)
+	case "scheduled_actions":
+		sys["status"] = "scheduled"
+	case "tags":
+		if bodySys, ok := body["sys"].(map[string]interface{}); ok {
+			sys["visibility"] = bodySys["visibility"]
+		}
+	case "entries":
+		if r != nil {
+			sys["contentType"] = fakeLink("ContentType", r.Header.Get("X-Contentful-Content-Type"))
+		}
+	case "api_keys":
+		previewID := f.nextID()
+		f.create(strings.Replace(collectionPath, "api_keys", "preview_api_keys", 1), previewID, map[string]interface{}{
+			"accessToken": "preview-token-" + previewID,
+		}, nil)
+
+		object["accessToken"] = "delivery-token-" + id
+		object["preview_api_key"] = fakeLink("PreviewApiKey", previewID)
+		if object["environments"] == nil {
+			object["environments"] = []interface{}{fakeLink("Environment", "master")}
+		}
+	}
+
+	return object
+}
+
+func (f *fakeCMA) checkVersion(w http.ResponseWriter, r *http.Request, object map[string]interface{}) bool {
+	header := r.Header.Get("X-Contentful-Version")
+	if header == "" || header == strconv.Itoa(fakeVersion(object)) {
+		return true
+	}
+
+	writeFakeError(w, http.StatusConflict, "VersionMismatch", "Version mismatch")
+
+	return false
+}
+
+func (f *fakeCMA) nextID() string {
+	f.counter++
+	return fmt.Sprintf("fake%d", f.counter)
+}
+
+func (f *fakeCMA) now() string {
+	f.counter++
+	return time.Date(2020, 1, 1, 0, 0, f.counter, 0, time.UTC).Format(time.RFC3339)
+}
+
+func (f *fakeCMA) sortedPaths() []string {
+	paths := make([]string, 0, len(f.objects))
+	for path := range f.objects {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+func fakeSys(object map[string]interface{}) map[string]interface{} {
+	return object["sys"].(map[string]interface{})
+}
+
+func fakeVersion(object map[string]interface{}) int {
+	return fakeInt(fakeSys(object)["version"])
+}
+
+func fakeInt(value interface{}) int {
+	switch v := value.(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+
+	return 0
+}
+
+// renameFields applies the newId of the fields of a content type, moving the
+// values of the renamed fields in its entries like the API does.
+func (f *fakeCMA) renameFields(path string, contentType map[string]interface{}) {
+	fields, _ := contentType["fields"].([]interface{})
+	entriesPath := path[:strings.LastIndex(path, "/content_types/")] + "/entries/"
+	contentTypeID := path[strings.LastIndex(path, "/")+1:]
+
+	for _, raw := range fields {
+		field := raw.(map[string]interface{})
+		newID, ok := field["newId"].(string)
+		if !ok {
+			continue
+		}
+
+		oldID := field["id"].(string)
+		field["id"] = newID
+		delete(field, "newId")
+
+		for key, entry := range f.objects {
+			if !strings.HasPrefix(key, entriesPath) || !fakeQueryMatches(entry, url.Values{"content_type": {contentTypeID}}) {
+				continue
+			}
+
+			if values, ok := entry["fields"].(map[string]interface{}); ok && values[oldID] != nil {
+				values[newID] = values[oldID]
+				delete(values, oldID)
+			}
+		}
+	}
+}
+
+// fakeQueryMatches implements the content_type, links_to_entry and
+// fields.<id>[exists] search parameters of the API.
+func fakeQueryMatches(object map[string]interface{}, query url.Values) bool {
+	for key := range query {
+		value := query.Get(key)
+
+		switch {
+		case key == "content_type":
+			contentType, _ := fakeSys(object)["contentType"].(map[string]interface{})
+			if contentType == nil || fakeSys(contentType)["id"] != value {
+				return false
+			}
+		case key == "sys.id[in]":
+			found := false
+			for _, id := range strings.Split(value, ",") {
+				found = found || fakeSys(object)["id"] == id
+			}
+
+			if !found {
+				return false
+			}
+		case key == "links_to_entry":
+			if !fakeLinksTo(object["fields"], "Entry", value) {
+				return false
+			}
+		case strings.HasPrefix(key, "fields.") && strings.HasSuffix(key, "[exists]"):
+			id := strings.TrimSuffix(strings.TrimPrefix(key, "fields."), "[exists]")
+			fields, _ := object["fields"].(map[string]interface{})
+			if _, exists := fields[id]; exists != (value == "true") {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// fakeLinksTo reports whether value contains a link to the given object.
+func fakeLinksTo(value interface{}, linkType, id string) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if sys, ok := v["sys"].(map[string]interface{}); ok && sys["type"] == "Link" {
+			return sys["linkType"] == linkType && sys["id"] == id
+		}
+
+		for _, item := range v {
+			if fakeLinksTo(item, linkType, id) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if fakeLinksTo(item, linkType, id) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func fakeLink(linkType, id string) map[string]interface{} {
+	return map[string]interface{}{
+		"sys": map[string]interface{}{
+			"type":     "Link",
+			"linkType": linkType,
+			"id":       id,
+		},
+	}
+}
+
+func copyFakeObject(object map[string]interface{}) map[string]interface{} {
+	bytesArray, _ := json.Marshal(object)
+
+	var copied map[string]interface{}
+	_ = json.Unmarshal(bytesArray, &copied)
+
+	return copied
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/vnd.contentful.management.v1+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, id, message string) {
+	writeFakeJSON(w, status, map[string]interface{}{
+		"sys":     map[string]interface{}{"type": "Error", "id": id},
+		"message": message,
+	})
+}
+
+// testFakeCheckDestroy verifies that no object of the given resource type is
+// left in the fake. pathFormat receives the space ID and the resource ID.
+func testFakeCheckDestroy(fake *fakeCMA, resourceType, pathFormat string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			path := fmt.Sprintf(pathFormat, rs.Primary.Attributes["space_id"], rs.Primary.ID)
+			if fake.get(path) != nil {
+				return fmt.Errorf("%s still exists at %s", resourceType, path)
+			}
+		}
+
+		return nil
+	}
+}
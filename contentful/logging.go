@@ -0,0 +1,65 @@
+package contentful
+
+import (
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+)
+
+// JSON strings are matched with their escapes, so an escaped quote or a
+// bracket inside a value does not end the match early.
+var (
+	authorizationHeaderPattern = regexp.MustCompile(`(?mi)^Authorization:[^\r\n]*`)
+	credentialPropertyPattern  = regexp.MustCompile(`"(accessToken|token|httpBasicPassword|password)"(\s*):(\s*)"(?:[^"\\]|\\.)*"`)
+	webhookHeadersPattern      = regexp.MustCompile(`"headers"\s*:\s*\[(?:[^\]"]|"(?:[^"\\]|\\.)*")*\]`)
+	headerValuePattern         = regexp.MustCompile(`"value"(\s*):(\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// loggingTransport writes every request and response to the Terraform debug
+// log, with credentials redacted so the log can be shared safely.
+type loggingTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if dump, err := httputil.DumpRequestOut(req, true); err == nil {
+		log.Printf("[DEBUG] Contentful API request:\n%s", redactCredentials(dump))
+	}
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if dump, err := httputil.DumpResponse(res, true); err == nil {
+		log.Printf("[DEBUG] Contentful API response:\n%s", redactCredentials(dump))
+	}
+
+	return res, nil
+}
+
+// newHTTPClient returns the HTTP client used for Content Management API
+// requests. Requests are only logged when TF_LOG is set.
+func newHTTPClient() *http.Client {
+	if logBoolean == "" {
+		return http.DefaultClient
+	}
+
+	return &http.Client{
+		Transport: &loggingTransport{transport: http.DefaultTransport},
+	}
+}
+
+// redactCredentials masks authorization headers, token properties and the
+// values of webhook headers, which usually carry credentials, in a dumped
+// request or response.
+func redactCredentials(dump []byte) string {
+	dump = authorizationHeaderPattern.ReplaceAll(dump, []byte("Authorization: [REDACTED]"))
+	dump = credentialPropertyPattern.ReplaceAll(dump, []byte(`"$1"$2:$3"[REDACTED]"`))
+	dump = webhookHeadersPattern.ReplaceAllFunc(dump, func(headers []byte) []byte {
+		return headerValuePattern.ReplaceAll(headers, []byte(`"value"$1:$2"[REDACTED]"`))
+	})
+
+	return string(dump)
+}
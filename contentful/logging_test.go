@@ -0,0 +1,31 @@
+package contentful
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactCredentials(t *testing.T) {
+	dump := "PUT /spaces/abc/webhook_definitions/def HTTP/1.1\r\n" +
+		"Host: api.contentful.com\r\n" +
+		"Authorization: Bearer CFPAT-secret\r\n" +
+		"\r\n" +
+		`{"name":"hook","httpBasicPassword":"hunter2","sys":{"id":"def"},"accessToken": "delivery-token",` +
+		`"headers":[{"key":"X-Api-Key","value":"header-secret"},{"key":"X-Quoted", "value": "quoted \"secret\""},` +
+		`{"key":"X-Bracket","value":"bracket]secret"},{"key":"X-Last","value":"last-secret"}],` +
+		`"password":"escaped\"tail-secret"}`
+
+	redacted := redactCredentials([]byte(dump))
+
+	for _, secret := range []string{"CFPAT-secret", "hunter2", "delivery-token", "header-secret", "quoted", "bracket", "last-secret", "tail-secret"} {
+		if strings.Contains(redacted, secret) {
+			t.Errorf("redacted dump still contains %q:\n%s", secret, redacted)
+		}
+	}
+
+	for _, kept := range []string{"Host: api.contentful.com", `"name":"hook"`, `"id":"def"`, `"key":"X-Api-Key"`} {
+		if !strings.Contains(redacted, kept) {
+			t.Errorf("redacted dump is missing %q:\n%s", kept, redacted)
+		}
+	}
+}
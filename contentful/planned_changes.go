@@ -0,0 +1,46 @@
+package contentful
+
+import (
+	"fmt"
+	"sync"
+
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// plannedChanges records, by the client of the provider that plans them, the
+// paths of the objects that are going to be created or changed. Terraform
+// plans a resource after the resources it depends on, so validation at plan
+// time can tell an object that is missing from Contentful apart from one that
+// the same apply creates.
+var plannedChanges = struct {
+	sync.Mutex
+	paths map[*contentful.Client]map[string]bool
+}{paths: map[*contentful.Client]map[string]bool{}}
+
+// planChange records that the object at path is going to be created or
+// changed.
+func planChange(client *contentful.Client, path string) {
+	plannedChanges.Lock()
+	defer plannedChanges.Unlock()
+
+	if plannedChanges.paths[client] == nil {
+		plannedChanges.paths[client] = map[string]bool{}
+	}
+
+	plannedChanges.paths[client][path] = true
+}
+
+// isChangePlanned reports whether the object at path is going to be created or
+// changed by a resource planned before.
+func isChangePlanned(client *contentful.Client, path string) bool {
+	plannedChanges.Lock()
+	defer plannedChanges.Unlock()
+
+	return plannedChanges.paths[client][path]
+}
+
+// localeCodePath identifies a locale by its code rather than its ID, since
+// other objects refer to locales by code.
+func localeCodePath(spaceID, code string) string {
+	return fmt.Sprintf("/spaces/%s/locales/%s", spaceID, code)
+}
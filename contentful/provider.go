@@ -13,6 +13,7 @@ func Provider() terraform.ResourceProvider {
 			"cma_token": {
 				Type:        schema.TypeString,
 				Required:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("CONTENTFUL_MANAGEMENT_TOKEN", nil),
 				Description: "The Contentful Management API token",
 			},
@@ -24,14 +25,25 @@ func Provider() terraform.ResourceProvider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"contentful_space":       resourceContentfulSpace(),
-			"contentful_contenttype": resourceContentfulContentType(),
-			"contentful_apikey":      resourceContentfulAPIKey(),
-			"contentful_webhook":     resourceContentfulWebhook(),
-			"contentful_locale":      resourceContentfulLocale(),
-			"contentful_environment": resourceContentfulEnvironment(),
-			"contentful_entry":       resourceContentfulEntry(),
-			"contentful_asset":       resourceContentfulAsset(),
+			"contentful_space":                   resourceContentfulSpace(),
+			"contentful_contenttype":             resourceContentfulContentType(),
+			"contentful_apikey":                  resourceContentfulAPIKey(),
+			"contentful_webhook":                 resourceContentfulWebhook(),
+			"contentful_locale":                  resourceContentfulLocale(),
+			"contentful_environment":             resourceContentfulEnvironment(),
+			"contentful_entry":                   resourceContentfulEntry(),
+			"contentful_entries":                 resourceContentfulEntries(),
+			"contentful_asset":                   resourceContentfulAsset(),
+			"contentful_bulk_publish":            resourceContentfulBulkPublish(),
+			"contentful_release":                 resourceContentfulRelease(),
+			"contentful_release_action":          resourceContentfulReleaseAction(),
+			"contentful_scheduled_action":        resourceContentfulScheduledAction(),
+			"contentful_tag":                     resourceContentfulTag(),
+			"contentful_taxonomy_concept":        resourceContentfulTaxonomyConcept(),
+			"contentful_taxonomy_concept_scheme": resourceContentfulTaxonomyConceptScheme(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"contentful_spaces": dataSourceContentfulSpaces(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
@@ -42,9 +54,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	cma := contentful.NewCMA(d.Get("cma_token").(string))
 	cma.SetOrganization(d.Get("organization_id").(string))
 
-	if logBoolean != "" {
-		cma.Debug = true
-	}
+	// contentful-go prints requests including the Authorization header when
+	// Debug is set, so logging is left to the redacting transport instead.
+	cma.SetHTTPClient(httpClient)
 
 	return cma, nil
 }
@@ -1,12 +1,21 @@
 package contentful
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/regressivetech/contentful-go"
 )
 
+// testAccPrefix starts the name or ID of every object created by the
+// acceptance tests, so the sweepers can find objects left behind by failed
+// runs.
+const testAccPrefix = "tf-acc-test"
+
 var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 
@@ -17,6 +26,10 @@ func init() {
 	}
 }
 
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -36,3 +49,27 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("CONTENTFUL_ORGANIZATION_ID must set with a valid Contentful Organization ID for acceptance tests")
 	}
 }
+
+// sharedClient returns a client for the sweepers, which run outside of a
+// Terraform provider.
+func sharedClient() (*contentful.Client, error) {
+	if CMAToken == "" || orgID == "" || spaceID == "" {
+		return nil, fmt.Errorf("CONTENTFUL_MANAGEMENT_TOKEN, CONTENTFUL_ORGANIZATION_ID and SPACE_ID must be set for sweepers")
+	}
+
+	client := contentful.NewCMA(CMAToken)
+	client.SetOrganization(orgID)
+	client.SetHTTPClient(httpClient)
+
+	return client, nil
+}
+
+// sharedEnvironment returns the environment the acceptance tests create
+// environment scoped objects in.
+func sharedEnvironment(client *contentful.Client) (*contentful.Environment, error) {
+	return client.Environments.Get(spaceID, envID)
+}
+
+func isTestAccObject(name string) bool {
+	return strings.HasPrefix(name, testAccPrefix)
+}
@@ -0,0 +1,248 @@
+package contentful
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// Statuses of an entry or asset, as shown in the Contentful web app.
+const (
+	statusDraft     = "draft"
+	statusChanged   = "changed"
+	statusPublished = "published"
+	statusArchived  = "archived"
+)
+
+// publishEntity is the part of an entry or asset that describes its state.
+type publishEntity struct {
+	Sys *contentful.Sys `json:"sys"`
+}
+
+// publishStatus derives the status of an entry or asset from its sys
+// properties. Publishing increases the version, so a published entity without
+// later changes is exactly one version ahead of its published version.
+func publishStatus(sys *contentful.Sys) string {
+	switch {
+	case sys.ArchivedVersion != 0 || sys.ArchivedAt != "":
+		return statusArchived
+	case sys.PublishedVersion == 0 && sys.PublishedAt == "":
+		return statusDraft
+	case sys.Version > sys.PublishedVersion+1:
+		return statusChanged
+	default:
+		return statusPublished
+	}
+}
+
+// setPublishMetadata sets the computed attributes that describe the publish
+// state of an entry or asset.
+func setPublishMetadata(d *schema.ResourceData, sys *contentful.Sys) error {
+	updatedBy := ""
+	if sys.UpdatedBy != nil {
+		updatedBy = sys.UpdatedBy.ID
+	}
+
+	values := map[string]interface{}{
+		"published_version":  sys.PublishedVersion,
+		"published_at":       sys.PublishedAt,
+		"first_published_at": sys.FirstPublishedAt,
+		"archived_at":        sys.ArchivedAt,
+		"updated_at":         sys.UpdatedAt,
+		"updated_by":         updatedBy,
+		"status":             publishStatus(sys),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePublishState rejects combinations of published and archived that
+// Contentful cannot represent.
+func validatePublishState(objectType string, published, archived bool) error {
+	if published && archived {
+		return fmt.Errorf("an archived %s cannot be published, set either published or archived to false", objectType)
+	}
+
+	return nil
+}
+
+// customizePublishDiff validates published and archived and plans an update
+// when the status of an existing entry or asset does not match them, for
+// example after a draft change was made in the web app. Neither is checked
+// when the publish state is not managed by the resource, since both are
+// ignored then.
+func customizePublishDiff(objectType string, d *schema.ResourceDiff) error {
+	if !d.Get("manage_publish_state").(bool) {
+		return nil
+	}
+
+	published := d.Get("published").(bool)
+	archived := d.Get("archived").(bool)
+
+	if err := validatePublishState(objectType, published, archived); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	status := statusDraft
+	switch {
+	case archived:
+		status = statusArchived
+	case published:
+		status = statusPublished
+	}
+
+	if d.Get("status").(string) != status {
+		return d.SetNew("status", status)
+	}
+
+	return nil
+}
+
+// setPublishState moves the entry or asset at path to the requested state.
+// Contentful only allows some transitions: a published entity has to be
+// unpublished before it is archived, and an archived one unarchived before it
+// is published. Every transition increases the version, so the entity
+// returned by each request is used for the next one, and the final sys
+// properties are returned.
+func setPublishState(client *contentful.Client, objectType, path string, published, archived bool) (*contentful.Sys, error) {
+	if err := validatePublishState(objectType, published, archived); err != nil {
+		return nil, err
+	}
+
+	var entity publishEntity
+	if err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &entity); err != nil {
+		return nil, err
+	}
+
+	transition := func(description, method, subPath string) error {
+		// Decoded into a new value, since properties like archivedAt are
+		// missing from the response once they no longer apply.
+		var updated publishEntity
+		if err := cmaRequest(client, method, path+subPath, nil, entity.Sys.Version, nil, &updated); err != nil {
+			return fmt.Errorf("%s %s %s: %w", description, objectType, entity.Sys.ID, err)
+		}
+
+		entity = updated
+
+		return nil
+	}
+
+	status := publishStatus(entity.Sys)
+
+	if status == statusArchived && !archived {
+		if err := transition("unarchiving", http.MethodDelete, "/archived"); err != nil {
+			return nil, err
+		}
+
+		status = publishStatus(entity.Sys)
+	}
+
+	switch {
+	case archived && (status == statusPublished || status == statusChanged):
+		if err := transition("unpublishing", http.MethodDelete, "/published"); err != nil {
+			return nil, err
+		}
+
+		if err := transition("archiving", http.MethodPut, "/archived"); err != nil {
+			return nil, err
+		}
+	case archived && status == statusDraft:
+		if err := transition("archiving", http.MethodPut, "/archived"); err != nil {
+			return nil, err
+		}
+	case published && (status == statusDraft || status == statusChanged):
+		if err := transition("publishing", http.MethodPut, "/published"); err != nil {
+			return nil, err
+		}
+	case !published && !archived && (status == statusPublished || status == statusChanged):
+		if err := transition("unpublishing", http.MethodDelete, "/published"); err != nil {
+			return nil, err
+		}
+	}
+
+	return entity.Sys, nil
+}
+
+// applyPublishState moves the entry or asset at path to the state requested
+// by published and archived, unless its publish state is managed elsewhere,
+// for example by contentful_bulk_publish. The final sys properties are
+// returned either way.
+func applyPublishState(d *schema.ResourceData, client *contentful.Client, objectType, path string) (*contentful.Sys, error) {
+	if d.Get("manage_publish_state").(bool) {
+		return setPublishState(client, objectType, path, d.Get("published").(bool), d.Get("archived").(bool))
+	}
+
+	var entity publishEntity
+	if err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &entity); err != nil {
+		return nil, err
+	}
+
+	return entity.Sys, nil
+}
+
+// deletePublishable deletes the entry or asset at path, unpublishing or
+// unarchiving it first since Contentful only deletes drafts. An entity that
+// is already gone counts as deleted.
+func deletePublishable(client *contentful.Client, objectType, path string, timeout time.Duration) error {
+	var notFound contentful.NotFoundError
+
+	sys, err := setPublishState(client, objectType, path, false, false)
+	if errors.As(err, &notFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = retryRateLimited(timeout, func() error {
+		return cmaRequest(client, http.MethodDelete, path, nil, sys.Version, nil, nil)
+	})
+	if errors.As(err, &notFound) {
+		return nil
+	}
+
+	return err
+}
+
+// entryReferences returns the IDs of the entries that link to the entry, up
+// to limit.
+func entryReferences(client *contentful.Client, spaceID, envID, entryID string, limit int) ([]string, int, error) {
+	query := url.Values{
+		"links_to_entry": {entryID},
+		"limit":          {strconv.Itoa(limit)},
+	}
+
+	var entries struct {
+		Total int              `json:"total"`
+		Items []*publishEntity `json:"items"`
+	}
+
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, envID)
+	if err := cmaRequest(client, http.MethodGet, path, query, 0, nil, &entries); err != nil {
+		return nil, 0, err
+	}
+
+	var ids []string
+	for _, entry := range entries.Items {
+		ids = append(ids, entry.Sys.ID)
+	}
+
+	return ids, entries.Total, nil
+}
@@ -0,0 +1,114 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+func TestPublishStatus(t *testing.T) {
+	cases := []struct {
+		sys    *contentful.Sys
+		status string
+	}{
+		{&contentful.Sys{Version: 1}, statusDraft},
+		{&contentful.Sys{Version: 2, PublishedVersion: 1, PublishedAt: "2020-01-01T00:00:00Z"}, statusPublished},
+		{&contentful.Sys{Version: 3, PublishedVersion: 1, PublishedAt: "2020-01-01T00:00:00Z"}, statusChanged},
+		{&contentful.Sys{Version: 3, ArchivedVersion: 2, ArchivedAt: "2020-01-01T00:00:00Z"}, statusArchived},
+	}
+
+	for _, c := range cases {
+		if status := publishStatus(c.sys); status != c.status {
+			t.Errorf("publishStatus(%+v) = %s, want %s", c.sys, status, c.status)
+		}
+	}
+}
+
+func TestSetPublishState(t *testing.T) {
+	fake := newFakeCMA(t)
+	client := fake.client()
+	path := fmt.Sprintf("/spaces/%s/environments/master/entries/tf-acc-test-entry", fakeSpaceID)
+
+	fake.put(path, map[string]interface{}{
+		"sys": map[string]interface{}{
+			"id":      "tf-acc-test-entry",
+			"type":    "Entry",
+			"version": 1,
+		},
+	})
+
+	steps := []struct {
+		name      string
+		change    bool
+		published bool
+		archived  bool
+		status    string
+		version   int
+	}{
+		{"publish draft", false, true, false, statusPublished, 2},
+		{"republish changes", true, true, false, statusPublished, 4},
+		{"archive published", false, false, true, statusArchived, 6},
+		{"publish archived", false, true, false, statusPublished, 8},
+		{"unpublish", false, false, false, statusDraft, 9},
+	}
+
+	for _, step := range steps {
+		if step.change {
+			entry := fake.get(path)
+			fakeSys(entry)["version"] = fakeVersion(entry) + 1
+			fake.put(path, entry)
+		}
+
+		sys, err := setPublishState(client, "entry", path, step.published, step.archived)
+		if err != nil {
+			t.Fatalf("%s: %s", step.name, err)
+		}
+
+		if status := publishStatus(sys); status != step.status {
+			t.Errorf("%s: status is %s, want %s", step.name, status, step.status)
+		}
+
+		if sys.Version != step.version || fakeVersion(fake.get(path)) != step.version {
+			t.Errorf("%s: version is %d, want %d", step.name, sys.Version, step.version)
+		}
+	}
+
+	if _, err := setPublishState(client, "entry", path, true, true); err == nil {
+		t.Errorf("expected an error for a published and archived entry")
+	}
+}
+
+func TestPublishStateTransitionErrors(t *testing.T) {
+	status := http.StatusConflict
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"sys": {"id": "tf-acc-test-entry", "version": 2, "publishedVersion": 1, "publishedAt": "2020-01-01T00:00:00Z"}}`)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("fake-token")
+	client.BaseURL = server.URL
+	path := fmt.Sprintf("/spaces/%s/environments/master/entries/tf-acc-test-entry", fakeSpaceID)
+
+	_, err := setPublishState(client, "entry", path, false, false)
+	if !isVersionMismatch(err) || !strings.Contains(err.Error(), "unpublishing entry tf-acc-test-entry") {
+		t.Errorf("expected a version mismatch while unpublishing, got %v", err)
+	}
+
+	// An entry deleted while it is unpublished counts as deleted.
+	status = http.StatusNotFound
+	if err := deletePublishable(client, "entry", path, time.Second); err != nil {
+		t.Errorf("expected a deleted entry to be ignored, got %v", err)
+	}
+}
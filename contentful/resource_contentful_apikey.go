@@ -1,6 +1,10 @@
 package contentful
 
 import (
+	"fmt"
+	"net/http"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	contentful "github.com/regressivetech/contentful-go"
 )
@@ -12,14 +16,26 @@ func resourceContentfulAPIKey() *schema.Resource {
 		Update: resourceUpdateAPIKey,
 		Delete: resourceDeleteAPIKey,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"access_token": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"preview_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"space_id": {
 				Type:     schema.TypeString,
@@ -33,19 +49,42 @@ func resourceContentfulAPIKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"environments": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
+// apiKeyPayload is the request body for creating and updating API keys.
+// contentful-go only sends the name and description of a key.
+type apiKeyPayload struct {
+	Name         string  `json:"name"`
+	Description  string  `json:"description,omitempty"`
+	Environments []*link `json:"environments,omitempty"`
+}
+
+// previewAPIKey is the preview API key linked to an API key.
+type previewAPIKey struct {
+	Sys         *contentful.Sys `json:"sys"`
+	AccessToken string          `json:"accessToken"`
+}
+
 func resourceCreateAPIKey(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Client)
+	spaceID := d.Get("space_id").(string)
 
 	apiKey := &contentful.APIKey{
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
 	}
 
-	err = client.APIKeys.Upsert(d.Get("space_id").(string), apiKey)
+	err = retryRateLimited(d.Timeout(schema.TimeoutCreate), func() error {
+		return upsertAPIKey(client, spaceID, apiKey, d.Get("environments").(*schema.Set).List())
+	})
 	if err != nil {
 		return err
 	}
@@ -54,6 +93,10 @@ func resourceCreateAPIKey(d *schema.ResourceData, m interface{}) (err error) {
 		return err
 	}
 
+	if err := setPreviewToken(d, client, spaceID, apiKey); err != nil {
+		return err
+	}
+
 	d.SetId(apiKey.Sys.ID)
 
 	return nil
@@ -72,7 +115,9 @@ func resourceUpdateAPIKey(d *schema.ResourceData, m interface{}) (err error) {
 	apiKey.Name = d.Get("name").(string)
 	apiKey.Description = d.Get("description").(string)
 
-	err = client.APIKeys.Upsert(spaceID, apiKey)
+	err = retryRateLimited(d.Timeout(schema.TimeoutUpdate), func() error {
+		return upsertAPIKey(client, spaceID, apiKey, d.Get("environments").(*schema.Set).List())
+	})
 	if err != nil {
 		return err
 	}
@@ -81,6 +126,10 @@ func resourceUpdateAPIKey(d *schema.ResourceData, m interface{}) (err error) {
 		return err
 	}
 
+	if err := setPreviewToken(d, client, spaceID, apiKey); err != nil {
+		return err
+	}
+
 	d.SetId(apiKey.Sys.ID)
 
 	return nil
@@ -97,7 +146,15 @@ func resourceReadAPIKey(d *schema.ResourceData, m interface{}) (err error) {
 		return nil
 	}
 
-	return setAPIKeyProperties(d, apiKey)
+	if err != nil {
+		return err
+	}
+
+	if err := setAPIKeyProperties(d, apiKey); err != nil {
+		return err
+	}
+
+	return setPreviewToken(d, client, spaceID, apiKey)
 }
 
 func resourceDeleteAPIKey(d *schema.ResourceData, m interface{}) (err error) {
@@ -110,7 +167,64 @@ func resourceDeleteAPIKey(d *schema.ResourceData, m interface{}) (err error) {
 		return err
 	}
 
-	return client.APIKeys.Delete(spaceID, apiKey)
+	return retryRateLimited(d.Timeout(schema.TimeoutDelete), func() error {
+		return client.APIKeys.Delete(spaceID, apiKey)
+	})
+}
+
+// upsertAPIKey creates or updates the API key together with the environments
+// it has access to. An empty environment list keeps the current links.
+func upsertAPIKey(client *contentful.Client, spaceID string, apiKey *contentful.APIKey, environments []interface{}) error {
+	payload := &apiKeyPayload{
+		Name:        apiKey.Name,
+		Description: apiKey.Description,
+	}
+
+	for _, environment := range environments {
+		envLink, err := environmentLink(client, spaceID, environment.(string))
+		if err != nil {
+			return err
+		}
+
+		payload.Environments = append(payload.Environments, envLink)
+	}
+
+	if apiKey.Sys != nil && apiKey.Sys.CreatedAt != "" {
+		path := fmt.Sprintf("/spaces/%s/api_keys/%s", spaceID, apiKey.Sys.ID)
+		return cmaRequest(client, http.MethodPut, path, nil, apiKey.Sys.Version, payload, apiKey)
+	}
+
+	path := fmt.Sprintf("/spaces/%s/api_keys", spaceID)
+	return cmaRequest(client, http.MethodPost, path, nil, 0, payload, apiKey)
+}
+
+// environmentLink returns a link to the environment or environment alias with
+// the given ID.
+func environmentLink(client *contentful.Client, spaceID, environmentID string) (*link, error) {
+	linkType := "Environment"
+
+	_, err := client.EnvironmentAliases.Get(spaceID, environmentID)
+	if err == nil {
+		linkType = "EnvironmentAlias"
+	} else if _, ok := err.(contentful.NotFoundError); !ok {
+		return nil, err
+	}
+
+	return newLink(linkType, environmentID), nil
+}
+
+func setPreviewToken(d *schema.ResourceData, client *contentful.Client, spaceID string, apiKey *contentful.APIKey) error {
+	if apiKey.PreviewAPIKey.Sys.ID == "" {
+		return d.Set("preview_token", "")
+	}
+
+	var preview previewAPIKey
+	path := fmt.Sprintf("/spaces/%s/preview_api_keys/%s", spaceID, apiKey.PreviewAPIKey.Sys.ID)
+	if err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &preview); err != nil {
+		return err
+	}
+
+	return d.Set("preview_token", preview.AccessToken)
 }
 
 func setAPIKeyProperties(d *schema.ResourceData, apiKey *contentful.APIKey) error {
@@ -134,5 +248,14 @@ func setAPIKeyProperties(d *schema.ResourceData, apiKey *contentful.APIKey) erro
 		return err
 	}
 
+	var environments []string
+	for _, environment := range apiKey.Environments {
+		environments = append(environments, environment.Sys.ID)
+	}
+
+	if err := d.Set("environments", environments); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -10,10 +10,41 @@ import (
 	contentful "github.com/regressivetech/contentful-go"
 )
 
+func init() {
+	resource.AddTestSweepers("contentful_apikey", &resource.Sweeper{
+		Name: "contentful_apikey",
+		F:    testSweepAPIKeys,
+	})
+}
+
+func testSweepAPIKeys(region string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+
+	var apiKeys []*contentful.APIKey
+	if err := cmaListAll(client, fmt.Sprintf("/spaces/%s/api_keys", spaceID), nil, &apiKeys); err != nil {
+		return err
+	}
+
+	for _, apiKey := range apiKeys {
+		if !isTestAccObject(apiKey.Name) {
+			continue
+		}
+
+		if err := client.APIKeys.Delete(spaceID, apiKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccContentfulAPIKey_Basic(t *testing.T) {
 	var apiKey contentful.APIKey
 
-	name := fmt.Sprintf("apikey-name-%s", acctest.RandString(3))
+	name := fmt.Sprintf("tf-acc-test-apikey-%s", acctest.RandString(3))
 	description := fmt.Sprintf("apikey-description-%s", acctest.RandString(3))
 
 	resource.Test(t, resource.TestCase{
@@ -36,6 +67,8 @@ func TestAccContentfulAPIKey_Basic(t *testing.T) {
 				Config: testAccContentfulAPIKeyUpdateConfig(name, description),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckContentfulAPIKeyExists("contentful_apikey.myapikey", &apiKey),
+					resource.TestCheckResourceAttrSet("contentful_apikey.myapikey", "preview_token"),
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "environments.#", "1"),
 					testAccCheckContentfulAPIKeyAttributes(&apiKey, map[string]interface{}{
 						"space_id":    spaceID,
 						"name":        fmt.Sprintf("%s-updated", name),
@@ -47,6 +80,34 @@ func TestAccContentfulAPIKey_Basic(t *testing.T) {
 	})
 }
 
+func TestContentfulAPIKey_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_apikey", "/spaces/%s/api_keys/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulAPIKeyConfig("tf-acc-test-apikey", "apikey-description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "name", "tf-acc-test-apikey"),
+					resource.TestCheckResourceAttrSet("contentful_apikey.myapikey", "access_token"),
+					resource.TestCheckResourceAttrSet("contentful_apikey.myapikey", "preview_token"),
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "environments.#", "1"),
+				),
+			},
+			{
+				Config: testFakeContentfulAPIKeyConfig("tf-acc-test-apikey-updated", "apikey-description-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "name", "tf-acc-test-apikey-updated"),
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "description", "apikey-description-updated"),
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContentfulAPIKeyExists(n string, apiKey *contentful.APIKey) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -141,6 +202,19 @@ resource "contentful_apikey" "myapikey" {
 
   name = "%s-updated"
   description = "%s-updated"
+  environments = ["master"]
 }
 `, spaceID, name, description)
 }
+
+func testFakeContentfulAPIKeyConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "contentful_apikey" "myapikey" {
+  space_id = "%s"
+
+  name = "%s"
+  description = "%s"
+  environments = ["master"]
+}
+`, fakeSpaceID, name, description)
+}
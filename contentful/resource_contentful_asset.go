@@ -1,6 +1,9 @@
 package contentful
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	contentful "github.com/regressivetech/contentful-go"
 )
@@ -12,15 +15,53 @@ func resourceContentfulAsset() *schema.Resource {
 		Update: resourceUpdateAsset,
 		Delete: resourceDeleteAsset,
 
+		CustomizeDiff: resourceAssetCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"asset_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
 			},
 			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"published_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"published_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_published_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"archived_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"locale": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -126,12 +167,27 @@ func resourceContentfulAsset() *schema.Resource {
 				},
 			},
 			"published": {
-				Type:     schema.TypeBool,
-				Required: true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the asset is published. Ignored when manage_publish_state is false.",
 			},
 			"archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the asset is archived. Ignored when manage_publish_state is false.",
+			},
+			"manage_publish_state": {
 				Type:     schema.TypeBool,
-				Required: true,
+				Optional: true,
+				Default:  true,
+			},
+			"tags": metadataLinksSchema(),
+			"force_overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -197,8 +253,7 @@ func resourceCreateAsset(d *schema.ResourceData, m interface{}) (err error) {
 		return err
 	}
 
-	err = client.Assets.Process(d.Get("space_id").(string), asset)
-	if err != nil {
+	if asset, err = processAsset(client, d.Get("space_id").(string), asset, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return err
 	}
 
@@ -216,14 +271,19 @@ func resourceCreateAsset(d *schema.ResourceData, m interface{}) (err error) {
 	return err
 }
 
+// assetKeys are the attributes that are saved with the asset itself. Tags and
+// the publish state are applied separately.
+var assetKeys = []string{"space_id", "locale", "fields"}
+
 func resourceUpdateAsset(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Client)
 	spaceID := d.Get("space_id").(string)
 	assetID := d.Id()
 
-	asset, err := client.Assets.Get(spaceID, assetID)
-	if err != nil {
-		return err
+	// Saving and processing the asset increases its version, so it is only
+	// saved when it changed.
+	if !hasChange(d, assetKeys...) {
+		return setAssetState(d, m)
 	}
 
 	fields := d.Get("fields").([]interface{})[0].(map[string]interface{})
@@ -244,9 +304,9 @@ func resourceUpdateAsset(d *schema.ResourceData, m interface{}) (err error) {
 
 	file := fields["file"].(map[string]interface{})
 
-	asset = &contentful.Asset{
+	asset := &contentful.Asset{
 		Sys: &contentful.Sys{
-			ID:      d.Get("asset_id").(string),
+			ID:      assetID,
 			Version: d.Get("version").(int),
 		},
 		Locale: d.Get("locale").(string),
@@ -278,13 +338,36 @@ func resourceUpdateAsset(d *schema.ResourceData, m interface{}) (err error) {
 		asset.Fields.File[d.Get("locale").(string)].UploadFrom.Sys.ID = uploadFrom
 	}
 
-	err = client.Assets.Upsert(d.Get("space_id").(string), asset)
+	// The version Terraform last read is sent, so changes made in Contentful
+	// since then are not silently overwritten.
+	err = client.Assets.Upsert(spaceID, asset)
+	if isVersionMismatch(err) {
+		remote, getErr := client.Assets.Get(spaceID, assetID)
+		if getErr != nil {
+			return getErr
+		}
+
+		if !d.Get("force_overwrite").(bool) {
+			oldFields, _ := d.GetChange("fields")
+
+			return &versionConflictError{
+				objectType:    "asset",
+				id:            assetID,
+				version:       asset.Sys.Version,
+				remoteVersion: remote.Sys.Version,
+				fields:        changedLocalizedFields(assetFieldValues(oldFields.([]interface{})), remoteAssetFieldValues(remote)),
+			}
+		}
+
+		asset.Sys.Version = remote.Sys.Version
+		err = client.Assets.Upsert(spaceID, asset)
+	}
+
 	if err != nil {
 		return err
 	}
 
-	err = client.Assets.Process(d.Get("space_id").(string), asset)
-	if err != nil {
+	if asset, err = processAsset(client, spaceID, asset, d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return err
 	}
 
@@ -302,28 +385,91 @@ func resourceUpdateAsset(d *schema.ResourceData, m interface{}) (err error) {
 	return err
 }
 
+// processAsset starts processing the files of the asset and waits until it
+// has finished, returning the asset with its new version.
+func processAsset(client *contentful.Client, spaceID string, asset *contentful.Asset, timeout time.Duration) (*contentful.Asset, error) {
+	err := retryRateLimited(timeout, func() error {
+		return client.Assets.Process(spaceID, asset)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return waitForAssetProcessing(client, spaceID, asset.Sys.ID, timeout)
+}
+
+// assetFieldValues returns the title and description of the asset as kept in
+// state, by locale.
+func assetFieldValues(rawFields []interface{}) map[string]map[string]string {
+	values := map[string]map[string]string{
+		"title":       {},
+		"description": {},
+	}
+
+	if len(rawFields) == 0 || rawFields[0] == nil {
+		return values
+	}
+
+	fields := rawFields[0].(map[string]interface{})
+	for id := range values {
+		for _, raw := range fields[id].([]interface{}) {
+			field := raw.(map[string]interface{})
+			values[id][field["locale"].(string)] = field["content"].(string)
+		}
+	}
+
+	return values
+}
+
+// remoteAssetFieldValues returns the title and description of the asset in
+// Contentful, by locale.
+func remoteAssetFieldValues(asset *contentful.Asset) map[string]map[string]string {
+	values := map[string]map[string]string{
+		"title":       {},
+		"description": {},
+	}
+
+	if asset.Fields == nil {
+		return values
+	}
+
+	for locale, value := range asset.Fields.Title {
+		values["title"][locale] = value
+	}
+
+	for locale, value := range asset.Fields.Description {
+		values["description"][locale] = value
+	}
+
+	return values
+}
+
+func resourceAssetCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if err := customizePublishDiff("asset", d); err != nil {
+		return err
+	}
+
+	return customizeVersionDiff(d, append([]string{"tags"}, assetKeys...)...)
+}
+
 func setAssetState(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Client)
-	spaceID := d.Get("space_id").(string)
-	assetID := d.Id()
+	path := fmt.Sprintf("/spaces/%s/assets/%s", d.Get("space_id").(string), d.Id())
 
-	asset, _ := client.Assets.Get(spaceID, assetID)
-
-	if d.Get("published").(bool) && asset.Sys.PublishedAt == "" {
-		err = client.Assets.Publish(spaceID, asset)
-	} else if !d.Get("published").(bool) && asset.Sys.PublishedAt != "" {
-		err = client.Assets.Unpublish(spaceID, asset)
+	if err = updateEntityMetadata(client, path, metadataLinksConfig(d, "tags")); err != nil {
+		return err
 	}
 
-	if d.Get("archived").(bool) && asset.Sys.ArchivedAt == "" {
-		err = client.Assets.Archive(spaceID, asset)
-	} else if !d.Get("archived").(bool) && asset.Sys.ArchivedAt != "" {
-		err = client.Assets.Unarchive(spaceID, asset)
+	sys, err := applyPublishState(d, client, "asset", path)
+	if err != nil {
+		return err
 	}
 
-	err = setAssetProperties(d, asset)
+	if err = d.Set("version", sys.Version); err != nil {
+		return err
+	}
 
-	return err
+	return setPublishMetadata(d, sys)
 }
 
 func resourceReadAsset(d *schema.ResourceData, m interface{}) (err error) {
@@ -337,20 +483,22 @@ func resourceReadAsset(d *schema.ResourceData, m interface{}) (err error) {
 		return nil
 	}
 
-	return setAssetProperties(d, asset)
+	if err != nil {
+		return err
+	}
+
+	if err := setAssetProperties(d, asset); err != nil {
+		return err
+	}
+
+	return setEntityMetadata(d, client, fmt.Sprintf("/spaces/%s/assets/%s", spaceID, assetID), "tags")
 }
 
 func resourceDeleteAsset(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Client)
-	spaceID := d.Get("space_id").(string)
-	assetID := d.Id()
-
-	asset, err := client.Assets.Get(spaceID, assetID)
-	if err != nil {
-		return err
-	}
+	path := fmt.Sprintf("/spaces/%s/assets/%s", d.Get("space_id").(string), d.Id())
 
-	return client.Assets.Delete(spaceID, asset)
+	return deletePublishable(client, "asset", path, d.Timeout(schema.TimeoutDelete))
 }
 
 func setAssetProperties(d *schema.ResourceData, asset *contentful.Asset) (err error) {
@@ -362,5 +510,13 @@ func setAssetProperties(d *schema.ResourceData, asset *contentful.Asset) (err er
 		return err
 	}
 
+	if err = setPublishMetadata(d, asset.Sys); err != nil {
+		return err
+	}
+
+	if err = d.Set("asset_id", asset.Sys.ID); err != nil {
+		return err
+	}
+
 	return err
 }
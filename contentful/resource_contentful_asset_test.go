@@ -2,13 +2,59 @@ package contentful
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	contentful "github.com/regressivetech/contentful-go"
 )
 
+func init() {
+	resource.AddTestSweepers("contentful_asset", &resource.Sweeper{
+		Name:         "contentful_asset",
+		F:            testSweepAssets,
+		Dependencies: []string{"contentful_entry"},
+	})
+}
+
+func testSweepAssets(region string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+
+	var assets []*contentful.Asset
+	if err := cmaListAll(client, fmt.Sprintf("/spaces/%s/assets", spaceID), nil, &assets); err != nil {
+		return err
+	}
+
+	for _, asset := range assets {
+		if !isTestAccObject(asset.Sys.ID) {
+			continue
+		}
+
+		if asset.Sys.PublishedAt != "" {
+			if err := client.Assets.Unpublish(spaceID, asset); err != nil {
+				return err
+			}
+		}
+
+		if asset.Sys.ArchivedAt != "" {
+			if err := client.Assets.Unarchive(spaceID, asset); err != nil {
+				return err
+			}
+		}
+
+		if err := client.Assets.Delete(spaceID, asset); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccContentfulAsset_Basic(t *testing.T) {
 	var asset contentful.Asset
 
@@ -39,6 +85,96 @@ func TestAccContentfulAsset_Basic(t *testing.T) {
 	})
 }
 
+func TestContentfulAsset_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_asset", "/spaces/%s/assets/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulAssetConfig("Asset title"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_asset.myasset", "id", "tf-acc-test-asset"),
+					resource.TestCheckResourceAttr("contentful_asset.myasset", "version", "3"),
+					resource.TestCheckResourceAttr("contentful_asset.myasset", "published_version", "2"),
+					resource.TestCheckResourceAttr("contentful_asset.myasset", "status", "published"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulAsset_VersionConflict(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_asset", "/spaces/%s/assets/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulAssetConfig("Asset title"),
+				Check:  testFakeCheckAssetVersionConflict(fake, "contentful_asset.myasset"),
+			},
+		},
+	})
+}
+
+// testFakeCheckAssetVersionConflict changes the asset behind Terraform's back
+// and checks that an update from the stale state is refused, unless
+// force_overwrite is set.
+func testFakeCheckAssetVersionConflict(fake *fakeCMA, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not Found: %s", n)
+		}
+
+		path := fmt.Sprintf("/spaces/%s/assets/%s", fakeSpaceID, rs.Primary.ID)
+		remote := fake.get(path)
+		remote["fields"].(map[string]interface{})["description"] = map[string]interface{}{"en-US": "Changed in the web app"}
+		fakeSys(remote)["version"] = fakeVersion(remote) + 1
+		fake.put(path, remote)
+
+		d, err := schema.InternalMap(resourceContentfulAsset().Schema).Data(rs.Primary, &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"fields.0.title.0.content": {Old: rs.Primary.Attributes["fields.0.title.0.content"], New: "Terraform title"},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = resourceUpdateAsset(d, fake.client())
+		if err == nil {
+			return fmt.Errorf("expected a version conflict")
+		}
+
+		if !strings.Contains(err.Error(), "remotely changed fields: description") {
+			return fmt.Errorf("unexpected error: %s", err)
+		}
+
+		if err = d.Set("force_overwrite", true); err != nil {
+			return err
+		}
+
+		if err = resourceUpdateAsset(d, fake.client()); err != nil {
+			return err
+		}
+
+		fields := fake.get(path)["fields"].(map[string]interface{})
+		if title := fields["title"].(map[string]interface{})["en-US"]; title != "Terraform title" {
+			return fmt.Errorf("the title was not overwritten: %v", title)
+		}
+
+		if description := fields["description"].(map[string]interface{})["en-US"]; description != "Asset description" {
+			return fmt.Errorf("the description was not overwritten: %v", description)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckContentfulAssetExists(n string, asset *contentful.Asset) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -109,7 +245,7 @@ func testAccContentfulAssetDestroy(s *terraform.State) error {
 
 var testAccContentfulAssetConfig = `
 resource "contentful_asset" "myasset" {
-  asset_id = "test_asset"
+  asset_id = "tf-acc-test-asset"
   locale = "en-US"
   space_id = "` + spaceID + `"
   fields {
@@ -134,7 +270,7 @@ resource "contentful_asset" "myasset" {
 
 var testAccContentfulAssetUpdateConfig = `
 resource "contentful_asset" "myasset" {
-  asset_id = "test_asset"
+  asset_id = "tf-acc-test-asset"
   locale = "en-US"
   space_id = "` + spaceID + `"
   fields {
@@ -156,3 +292,30 @@ resource "contentful_asset" "myasset" {
   archived = false
 }
 `
+
+func testFakeContentfulAssetConfig(title string) string {
+	return fmt.Sprintf(`
+resource "contentful_asset" "myasset" {
+  asset_id = "tf-acc-test-asset"
+  locale = "en-US"
+  space_id = "%s"
+  fields {
+    title {
+      locale = "en-US"
+      content = "%s"
+    }
+    description {
+      locale = "en-US"
+      content = "Asset description"
+    }
+    file = {
+      upload = "https://example.com/example.jpeg"
+      fileName = "example.jpeg"
+      contentType = "image/jpeg"
+    }
+  }
+  published = true
+  archived = false
+}
+`, fakeSpaceID, title)
+}
@@ -0,0 +1,266 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// bulkActionLimit is the number of entities a bulk action accepts.
+const bulkActionLimit = 200
+
+// bulkCollections maps the entity types of bulk actions to their collection
+// and the attribute listing them.
+var bulkCollections = []struct {
+	linkType   string
+	collection string
+	attribute  string
+}{
+	{"Entry", "entries", "entries"},
+	{"Asset", "assets", "assets"},
+}
+
+func resourceContentfulBulkPublish() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateBulkPublish,
+		Read:   resourceReadBulkPublish,
+		Update: resourceUpdateBulkPublish,
+		Delete: resourceDeleteBulkPublish,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Entities that are not published or have unpublished changes
+			// are missing from the state, so the next apply publishes them.
+			"entries": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"assets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"validate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			// Any change to triggers publishes the entities again, for
+			// example when their fields change. Versions do not work as
+			// triggers, since publishing increments them.
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"unpublish_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceCreateBulkPublish(d *schema.ResourceData, m interface{}) (err error) {
+	if err = bulkPublish(d, m.(*contentful.Client), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return resourceReadBulkPublish(d, m)
+}
+
+func resourceUpdateBulkPublish(d *schema.ResourceData, m interface{}) (err error) {
+	if err = bulkPublish(d, m.(*contentful.Client), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceReadBulkPublish(d, m)
+}
+
+func resourceReadBulkPublish(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	for _, c := range bulkCollections {
+		entities, err := bulkEntities(client, d, c.collection, c.attribute)
+		if err != nil {
+			return err
+		}
+
+		published := []string{}
+		for id, sys := range entities {
+			if publishStatus(sys) == statusPublished {
+				published = append(published, id)
+			}
+		}
+
+		if err = d.Set(c.attribute, published); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceDeleteBulkPublish(d *schema.ResourceData, m interface{}) (err error) {
+	if !d.Get("unpublish_on_destroy").(bool) {
+		return nil
+	}
+
+	client := m.(*contentful.Client)
+
+	var links []*link
+	for _, c := range bulkCollections {
+		entities, err := bulkEntities(client, d, c.collection, c.attribute)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range sortedKeys(entities) {
+			if status := publishStatus(entities[id]); status == statusPublished || status == statusChanged {
+				links = append(links, newLink(c.linkType, id))
+			}
+		}
+	}
+
+	return runBulkActions(client, d, "unpublish", links, d.Timeout(schema.TimeoutDelete))
+}
+
+// bulkPublish publishes the entries and assets that are drafts or have
+// unpublished changes, after validating them if requested. Entities that are
+// already published are left alone.
+func bulkPublish(d *schema.ResourceData, client *contentful.Client, timeout time.Duration) error {
+	var links []*link
+	var missing []string
+
+	for _, c := range bulkCollections {
+		entities, err := bulkEntities(client, d, c.collection, c.attribute)
+		if err != nil {
+			return err
+		}
+
+		for _, raw := range d.Get(c.attribute).(*schema.Set).List() {
+			id := raw.(string)
+
+			sys, ok := entities[id]
+			if !ok {
+				missing = append(missing, fmt.Sprintf("%s %s", strings.ToLower(c.linkType), id))
+				continue
+			}
+
+			if status := publishStatus(sys); status == statusDraft || status == statusChanged {
+				versioned := newLink(c.linkType, id)
+				versioned.Sys.Version = sys.Version
+				links = append(links, versioned)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("cannot publish entities that do not exist: %s", strings.Join(missing, ", "))
+	}
+
+	if d.Get("validate").(bool) {
+		if err := runBulkActions(client, d, "validate", links, timeout); err != nil {
+			return err
+		}
+	}
+
+	return runBulkActions(client, d, "publish", links, timeout)
+}
+
+// runBulkActions runs the bulk action for the linked entities, in batches of
+// the size Contentful accepts, and waits for each of them.
+func runBulkActions(client *contentful.Client, d *schema.ResourceData, action string, links []*link, timeout time.Duration) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/bulk_actions", d.Get("space_id").(string), d.Get("env_id").(string))
+
+	for start := 0; start < len(links); start += bulkActionLimit {
+		end := start + bulkActionLimit
+		if end > len(links) {
+			end = len(links)
+		}
+
+		body := map[string]interface{}{
+			"entities": map[string]interface{}{
+				"sys":   map[string]string{"type": "Array"},
+				"items": links[start:end],
+			},
+		}
+
+		if action == "validate" {
+			body["action"] = "publish"
+		}
+
+		var started asyncAction
+		if err := cmaRequest(client, http.MethodPost, path+"/"+action, nil, 0, body, &started); err != nil {
+			return err
+		}
+
+		if _, err := waitForAsyncAction(client, path+"/actions/"+started.Sys.ID, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bulkEntities returns the sys properties of the configured entities of a
+// collection that exist, by ID.
+func bulkEntities(client *contentful.Client, d *schema.ResourceData, collection, attribute string) (map[string]*contentful.Sys, error) {
+	var ids []string
+	for _, id := range d.Get(attribute).(*schema.Set).List() {
+		ids = append(ids, id.(string))
+	}
+
+	sort.Strings(ids)
+
+	path := fmt.Sprintf("/spaces/%s/environments/%s/%s", d.Get("space_id").(string), d.Get("env_id").(string), collection)
+
+	var page []*publishEntity
+	if err := cmaListByIDs(client, path, ids, &page); err != nil {
+		return nil, err
+	}
+
+	entities := map[string]*contentful.Sys{}
+	for _, entity := range page {
+		entities[entity.Sys.ID] = entity.Sys
+	}
+
+	return entities, nil
+}
+
+func sortedKeys(entities map[string]*contentful.Sys) []string {
+	var keys []string
+	for key := range entities {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,195 @@
+package contentful
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContentfulBulkPublish_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulBulkPublishConfig("Hello, Bulk!"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_bulk_publish.mypublish", "entries.#", "1"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "status", "published"),
+				),
+			},
+			{
+				Config: testAccContentfulBulkPublishConfig("Hello again, Bulk!"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_bulk_publish.mypublish", "entries.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulBulkPublish_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+	entriesPath := fmt.Sprintf("/spaces/%s/environments/master/entries/", fakeSpaceID)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: fake.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulBulkPublishConfig("First", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_bulk_publish.mypublish", "entries.#", "2"),
+					testFakeCheckPublished(fake, entriesPath+"tf-acc-test-entry-1"),
+					testFakeCheckPublished(fake, entriesPath+"tf-acc-test-entry-2"),
+				),
+			},
+			{
+				// The changed entry is published again in the same apply,
+				// since its fields are one of the triggers.
+				Config: testFakeContentfulBulkPublishConfig("First, updated", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeCheckPublished(fake, entriesPath+"tf-acc-test-entry-1"),
+					resource.TestCheckResourceAttr("contentful_bulk_publish.mypublish", "entries.#", "2"),
+				),
+			},
+			{
+				// An entry unpublished in the web app is published again.
+				PreConfig: func() {
+					entry := fake.get(entriesPath + "tf-acc-test-entry-2")
+					delete(fakeSys(entry), "publishedVersion")
+					delete(fakeSys(entry), "publishedAt")
+					fake.put(entriesPath+"tf-acc-test-entry-2", entry)
+				},
+				Config: testFakeContentfulBulkPublishConfig("First, updated", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeCheckPublished(fake, entriesPath+"tf-acc-test-entry-2"),
+				),
+			},
+			{
+				Config:      testFakeContentfulBulkPublishConfig("First, updated", `"tf-acc-test-missing"`),
+				ExpectError: regexp.MustCompile("cannot publish entities that do not exist: entry tf-acc-test-missing"),
+			},
+		},
+	})
+}
+
+func TestRunBulkActions_Failure(t *testing.T) {
+	fake := newFakeCMA(t)
+	d := resourceContentfulBulkPublish().TestResourceData()
+	_ = d.Set("space_id", fakeSpaceID)
+	_ = d.Set("env_id", "master")
+
+	path := fmt.Sprintf("/spaces/%s/environments/master/entries/tf-acc-test-entry", fakeSpaceID)
+	fake.put(path, map[string]interface{}{
+		"sys": map[string]interface{}{"id": "tf-acc-test-entry", "type": "Entry", "version": 3},
+	})
+
+	stale := newLink("Entry", "tf-acc-test-entry")
+	stale.Sys.Version = 2
+
+	err := runBulkActions(fake.client(), d, "publish", []*link{stale}, time.Second)
+	if err == nil || !regexp.MustCompile("entry tf-acc-test-entry: VersionMismatch").MatchString(err.Error()) {
+		t.Fatalf("expected a version mismatch for the entry, got %v", err)
+	}
+
+	if fakeSys(fake.get(path))["publishedVersion"] != nil {
+		t.Errorf("entry was published despite the failed bulk action")
+	}
+}
+
+func testAccContentfulBulkPublishConfig(content string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  name = "tf-acc-test-bulk"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "%[3]s"
+    locale = "en-US"
+  }
+  manage_publish_state = false
+}
+
+resource "contentful_bulk_publish" "mypublish" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  entries = [contentful_entry.myentry.id]
+  triggers = {
+    myentry = jsonencode(contentful_entry.myentry.field)
+  }
+}
+`, spaceID, envID, content)
+}
+
+func testFakeContentfulBulkPublishConfig(content, extraEntries string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "first" {
+  entry_id = "tf-acc-test-entry-1"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "%[2]s"
+    locale = "en-US"
+  }
+  manage_publish_state = false
+}
+
+resource "contentful_entry" "second" {
+  entry_id = "tf-acc-test-entry-2"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "Second"
+    locale = "en-US"
+  }
+  manage_publish_state = false
+}
+
+resource "contentful_bulk_publish" "mypublish" {
+  space_id = "%[1]s"
+  env_id = "master"
+  entries = [contentful_entry.first.id, contentful_entry.second.id, %[3]s]
+  triggers = {
+    first  = jsonencode(contentful_entry.first.field)
+    second = jsonencode(contentful_entry.second.field)
+  }
+  unpublish_on_destroy = true
+}
+`, fakeSpaceID, content, extraEntries)
+}
@@ -2,6 +2,13 @@ package contentful
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	contentful "github.com/regressivetech/contentful-go"
@@ -14,6 +21,14 @@ func resourceContentfulContentType() *schema.Resource {
 		Update: resourceContentTypeUpdate,
 		Delete: resourceContentTypeDelete,
 
+		CustomizeDiff: resourceContentTypeCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"space_id": {
 				Type:     schema.TypeString,
@@ -34,26 +49,66 @@ func resourceContentfulContentType() *schema.Resource {
 			},
 			"display_field": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"content_type_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
+				ForceNew: true,
 			},
 			"env_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"published_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"has_unpublished_changes": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"allow_data_loss": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"reorder_fields": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"taxonomy": contentTypeTaxonomySchema(),
+			"definition_json": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"field"},
+				ValidateFunc:  validateContentTypeDefinition,
+				StateFunc:     normalizeContentTypeDefinition,
+				Description:   "A content type as found in the output of `contentful space export`. Only its display field and fields are used. A name or description in it must match the attribute of the same name, and field properties the provider cannot manage, like defaultValue, are rejected.",
+			},
 			"field": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:             schema.TypeList,
+				Optional:         true,
+				ConflictsWith:    []string{"definition_json"},
+				DiffSuppressFunc: suppressFieldReorder,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"previous_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"name": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -129,14 +184,10 @@ func resourceContentTypeCreate(d *schema.ResourceData, m interface{}) (err error
 		Name:         d.Get("name").(string),
 		DisplayField: d.Get("display_field").(string),
 		Fields:       []*contentful.Field{},
-		Sys: &contentful.Sys{
-			ID: d.Get("content_type_id").(string),
-		},
 	}
 
-	id := d.Get("content_type_id")
-
-	if id != nil {
+	// Without a content_type_id, Contentful generates the ID.
+	if id, ok := d.GetOk("content_type_id"); ok {
 		ct.Sys = &contentful.Sys{
 			ID: id.(string),
 		}
@@ -156,6 +207,19 @@ func resourceContentTypeCreate(d *schema.ResourceData, m interface{}) (err error
 		ct.Description = description.(string)
 	}
 
+	if definition, ok := d.GetOk("definition_json"); ok {
+		parsed, err := parseContentTypeDefinition(definition.(string))
+		if err != nil {
+			return err
+		}
+
+		ct.Fields = parsed.Fields
+
+		if ct.DisplayField == "" {
+			ct.DisplayField = parsed.DisplayField
+		}
+	}
+
 	rawField := d.Get("field").([]interface{})
 	for i := 0; i < len(rawField); i++ {
 		field := rawField[i].(map[string]interface{})
@@ -194,12 +258,14 @@ func resourceContentTypeCreate(d *schema.ResourceData, m interface{}) (err error
 		return err
 	}
 
-	if err != nil {
+	if err = updateContentTypeTaxonomy(client, spaceID, envID, ct, d.Get("taxonomy").([]interface{})); err != nil {
 		return err
 	}
 
-	if err = client.ContentTypes.Activate(env, ct); err != nil {
-		return err
+	if d.Get("active").(bool) {
+		if err = activateContentType(client, env, ct, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
 	}
 
 	if err = setContentTypeProperties(d, ct); err != nil {
@@ -211,17 +277,35 @@ func resourceContentTypeCreate(d *schema.ResourceData, m interface{}) (err error
 	return nil
 }
 
+// remoteContentType is a content type as returned by the Content Management
+// API, with the metadata that contentful-go does not know about. The fields
+// are kept as they are, since contentful-go drops validations it does not
+// know about when it decodes them.
+type remoteContentType struct {
+	contentful.ContentType
+	Fields   []map[string]interface{} `json:"fields"`
+	Metadata map[string]interface{}   `json:"metadata"`
+}
+
 func resourceContentTypeRead(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Client)
 	spaceID := d.Get("space_id").(string)
 	envID := d.Get("env_id").(string)
-	env, err := client.Environments.Get(spaceID, envID)
 
-	if err != nil {
+	var ct remoteContentType
+	if err = cmaRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, envID, d.Id()), nil, 0, nil, &ct); err != nil {
 		return err
 	}
-	_, err = client.ContentTypes.Get(env, d.Id())
-	return err
+
+	if err = setContentTypeProperties(d, &ct.ContentType); err != nil {
+		return err
+	}
+
+	if err = setContentTypeDefinition(d, &ct); err != nil {
+		return err
+	}
+
+	return d.Set("taxonomy", flattenContentTypeTaxonomy(ct.Metadata))
 }
 
 func resourceContentTypeUpdate(d *schema.ResourceData, m interface{}) (err error) {
@@ -242,25 +326,79 @@ func resourceContentTypeUpdate(d *schema.ResourceData, m interface{}) (err error
 		return err
 	}
 
+	// Renamed fields keep their data, so they are renamed in place before the
+	// remaining changes are applied. Until then the content type has the
+	// previous IDs, so it is compared to the planned fields as if renamed.
+	var renames map[string]string
+	if d.HasChange("field") {
+		old, nw := d.GetChange("field")
+		renames = fieldRenames(old.([]interface{}), nw.([]interface{}))
+	}
+
+	currentFields := renamedFields(ct.Fields, renames)
+
+	var definition *contentTypeDefinition
+	if raw, ok := d.GetOk("definition_json"); ok {
+		if definition, err = parseContentTypeDefinition(raw.(string)); err != nil {
+			return err
+		}
+	}
+
 	ct.Name = d.Get("name").(string)
 	ct.DisplayField = d.Get("display_field").(string)
 
+	if ct.DisplayField == "" && definition != nil {
+		ct.DisplayField = definition.DisplayField
+	}
+
 	if description, ok := d.GetOk("description"); ok {
 		ct.Description = description.(string)
 	}
 
-	if d.HasChange("field") {
+	// Fields removed while the content type was inactive stay omitted in its
+	// draft, so they are removed as well when it is activated.
+	activatingOmitted := d.Get("active").(bool) && hasOmittedField(currentFields)
+
+	// The fields read by contentful-go lack the validations it does not know
+	// about, so the ones of definition_json are always sent.
+	if d.HasChange("field") || definition != nil || activatingOmitted {
 		old, nw := d.GetChange("field")
 
-		existingFields, deletedFields = checkFieldChanges(old.([]interface{}), nw.([]interface{}))
+		if definition != nil {
+			existingFields = definition.Fields
+		} else {
+			existingFields, _ = checkFieldChanges(old.([]interface{}), nw.([]interface{}))
+		}
+
+		// The removed fields are the ones the content type has now, since
+		// they include the ones removed while it was inactive.
+		deletedFields = removedFields(currentFields, existingFields)
+
+		if !d.Get("reorder_fields").(bool) {
+			existingFields = keepFieldOrder(existingFields, currentFields)
+		}
 
 		ct.Fields = existingFields
 
 		if deletedFields != nil {
+			if !d.Get("allow_data_loss").(bool) {
+				if err = checkFieldDataLoss(client, spaceID, envID, ct.Sys.ID, deletedFields); err != nil {
+					return err
+				}
+			}
+
 			ct.Fields = append(ct.Fields, deletedFields...)
 		}
 	}
 
+	// Nothing is written before this point, so a refused update leaves the
+	// content type as it was.
+	if len(renames) > 0 {
+		if ct.Sys.Version, err = renameContentTypeFields(client, spaceID, envID, d.Id(), renames, d.Get("active").(bool)); err != nil {
+			return err
+		}
+	}
+
 	// To remove a field from a content type 4 API calls need to be made.
 	// Omit the removed fields and publish the new version of the content type,
 	// followed by the field removal and final publish.
@@ -268,7 +406,17 @@ func resourceContentTypeUpdate(d *schema.ResourceData, m interface{}) (err error
 		return err
 	}
 
-	if err = client.ContentTypes.Activate(env, ct); err != nil {
+	if err = updateContentTypeTaxonomy(client, spaceID, envID, ct, d.Get("taxonomy").([]interface{})); err != nil {
+		return err
+	}
+
+	// An inactive content type is only saved as a draft. Removed fields stay
+	// omitted in the draft until it is activated.
+	if !d.Get("active").(bool) {
+		return setContentTypeProperties(d, ct)
+	}
+
+	if err = activateContentType(client, env, ct, d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return err
 	}
 
@@ -279,7 +427,11 @@ func resourceContentTypeUpdate(d *schema.ResourceData, m interface{}) (err error
 			return err
 		}
 
-		if err = client.ContentTypes.Activate(env, ct); err != nil {
+		if err = updateContentTypeTaxonomy(client, spaceID, envID, ct, d.Get("taxonomy").([]interface{})); err != nil {
+			return err
+		}
+
+		if err = activateContentType(client, env, ct, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return err
 		}
 	}
@@ -299,26 +451,85 @@ func resourceContentTypeDelete(d *schema.ResourceData, m interface{}) (err error
 		return err
 	}
 
-	if err = client.ContentTypes.Deactivate(env, ct); err != nil {
-		return err
+	if ct.Sys.PublishedVersion != 0 {
+		err = retryRateLimited(d.Timeout(schema.TimeoutDelete), func() error {
+			return client.ContentTypes.Deactivate(env, ct)
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	if err = client.ContentTypes.Delete(env, ct); err != nil {
+	err = retryRateLimited(d.Timeout(schema.TimeoutDelete), func() error {
+		return client.ContentTypes.Delete(env, ct)
+	})
+	if err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// activateContentType publishes the content type, retrying while Contentful
+// is rate limiting.
+func activateContentType(client *contentful.Client, env *contentful.Environment, ct *contentful.ContentType, timeout time.Duration) error {
+	return retryRateLimited(timeout, func() error {
+		return client.ContentTypes.Activate(env, ct)
+	})
+}
+
 func setContentTypeProperties(d *schema.ResourceData, ct *contentful.ContentType) (err error) {
+	if err = d.Set("content_type_id", ct.Sys.ID); err != nil {
+		return err
+	}
 
 	if err = d.Set("version", ct.Sys.Version); err != nil {
 		return err
 	}
 
+	if err = d.Set("published_version", ct.Sys.PublishedVersion); err != nil {
+		return err
+	}
+
+	// Activating a content type increases its version, so the published
+	// version lags the draft when the draft has been changed since.
+	hasUnpublishedChanges := ct.Sys.PublishedVersion == 0 || ct.Sys.Version > ct.Sys.PublishedVersion+1
+	if err = d.Set("has_unpublished_changes", hasUnpublishedChanges); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// setContentTypeDefinition refreshes definition_json, when it is used, from the
+// display field and fields of the content type, so that changes made in the
+// web app show up in the plan. The display field is only kept when the
+// definition sets it.
+func setContentTypeDefinition(d *schema.ResourceData, ct *remoteContentType) error {
+	definition, err := parseContentTypeDefinition(d.Get("definition_json").(string))
+	if err != nil {
+		return nil
+	}
+
+	refreshed := &contentTypeDefinition{}
+	if definition.DisplayField != "" {
+		refreshed.DisplayField = ct.DisplayField
+	}
+
+	// Properties that definition_json cannot set are left out, like the
+	// default values set in the web app.
+	for _, raw := range ct.Fields {
+		field, _, err := decodeDefinitionField(raw)
+		if err != nil {
+			return err
+		}
+
+		refreshed.Fields = append(refreshed.Fields, field)
+	}
+
+	return d.Set("definition_json", marshalContentTypeDefinition(refreshed))
+}
+
 func checkFieldChanges(old, new []interface{}) ([]*contentful.Field, []*contentful.Field) {
 	var contentfulField *contentful.Field
 	var existingFields []*contentful.Field
@@ -330,7 +541,8 @@ func checkFieldChanges(old, new []interface{}) ([]*contentful.Field, []*contentf
 
 		fieldRemoved = true
 		for j := 0; j < len(new); j++ {
-			if oldField["id"].(string) == new[j].(map[string]interface{})["id"].(string) {
+			newField := new[j].(map[string]interface{})
+			if oldField["id"].(string) == newField["id"].(string) || oldField["id"].(string) == newField["previous_id"].(string) {
 				fieldRemoved = false
 				break
 			}
@@ -384,6 +596,312 @@ func checkFieldChanges(old, new []interface{}) ([]*contentful.Field, []*contentf
 	return existingFields, deletedFields
 }
 
+func resourceContentTypeCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if err := validateContentTypeTaxonomy(d); err != nil {
+		return err
+	}
+
+	// Entries of the content type are only checked against its fields when
+	// they are not going to change.
+	if d.NewValueKnown("space_id") && d.NewValueKnown("env_id") && d.NewValueKnown("content_type_id") &&
+		(d.Id() == "" || d.HasChange("field") || d.HasChange("definition_json")) {
+		planChange(m.(*contentful.Client), fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", d.Get("space_id").(string), d.Get("env_id").(string), d.Get("content_type_id").(string)))
+	}
+
+	if !d.NewValueKnown("display_field") || !d.NewValueKnown("field") || !d.NewValueKnown("definition_json") {
+		return nil
+	}
+
+	displayField := d.Get("display_field").(string)
+	fields := d.Get("field").([]interface{})
+
+	if definition := d.Get("definition_json").(string); definition != "" {
+		parsed, err := parseContentTypeDefinition(definition)
+		if err != nil {
+			return err
+		}
+
+		if displayField == "" {
+			displayField = parsed.DisplayField
+		}
+
+		fields = definitionFieldMaps(parsed.Fields)
+
+		if d.NewValueKnown("name") && d.NewValueKnown("description") {
+			if err := checkContentTypeDefinitionProperties(definition, d.Get("name").(string), d.Get("description").(string)); err != nil {
+				return err
+			}
+		}
+	} else if len(fields) == 0 {
+		return fmt.Errorf("content type fields must be defined with field blocks or definition_json")
+	}
+
+	for i := range d.Get("field").([]interface{}) {
+		if !d.NewValueKnown(fmt.Sprintf("field.%d.id", i)) || !d.NewValueKnown(fmt.Sprintf("field.%d.type", i)) {
+			return nil
+		}
+	}
+
+	if err := validateContentTypeFields(displayField, fields); err != nil {
+		return err
+	}
+
+	// A draft saved in the web app is activated on the next apply.
+	if d.Id() != "" && d.Get("active").(bool) && d.Get("has_unpublished_changes").(bool) {
+		return d.SetNew("has_unpublished_changes", false)
+	}
+
+	return nil
+}
+
+// validateContentTypeFields catches field definitions that Contentful would
+// only reject when the content type is activated, after its draft has been
+// saved.
+func validateContentTypeFields(displayField string, fields []interface{}) error {
+	types := map[string]string{}
+
+	for _, raw := range fields {
+		field := raw.(map[string]interface{})
+		id := field["id"].(string)
+		fieldType := field["type"].(string)
+		linkType := field["link_type"].(string)
+		items := field["items"].([]interface{})
+
+		if _, ok := types[id]; ok {
+			return fmt.Errorf("field ID %s is used by more than one field", id)
+		}
+
+		types[id] = fieldType
+
+		if linkType != "" && fieldType != "Link" && fieldType != "Array" {
+			return fmt.Errorf("field %s has link_type %s but is of type %s, link_type requires type Link or Array", id, linkType, fieldType)
+		}
+
+		if fieldType == "Link" && linkType == "" {
+			return fmt.Errorf("field %s is of type Link and needs a link_type", id)
+		}
+
+		if len(items) > 0 && fieldType != "Array" {
+			return fmt.Errorf("field %s has items but is of type %s, items require type Array", id, fieldType)
+		}
+
+		if fieldType == "Array" && len(items) == 0 {
+			return fmt.Errorf("field %s is of type Array and needs items", id)
+		}
+	}
+
+	if displayField == "" {
+		return fmt.Errorf("display_field must be set")
+	}
+
+	fieldType, ok := types[displayField]
+	if !ok {
+		return fmt.Errorf("display_field %s does not match any field of the content type", displayField)
+	}
+
+	if fieldType != "Symbol" && fieldType != "Text" {
+		return fmt.Errorf("display_field %s is of type %s, the display field must be of type Symbol or Text", displayField, fieldType)
+	}
+
+	return nil
+}
+
+// fieldRenames returns the new ID of every field whose previous_id names a
+// field that is being replaced, by previous ID.
+func fieldRenames(old, new []interface{}) map[string]string {
+	oldIDs := map[string]bool{}
+	for _, raw := range old {
+		oldIDs[raw.(map[string]interface{})["id"].(string)] = true
+	}
+
+	renames := map[string]string{}
+	for _, raw := range new {
+		field := raw.(map[string]interface{})
+		id := field["id"].(string)
+		previousID := field["previous_id"].(string)
+
+		if previousID != "" && previousID != id && oldIDs[previousID] && !oldIDs[id] {
+			renames[previousID] = id
+		}
+	}
+
+	return renames
+}
+
+// renameContentTypeFields changes the IDs of fields of the content type and,
+// when activate is set, activates it. It returns the new version. Unlike
+// removing a field and adding a new one, this keeps the values of the fields
+// in all entries. An inactive content type keeps the renames in its draft
+// until it is activated.
+func renameContentTypeFields(client *contentful.Client, spaceID, envID, contentTypeID string, renames map[string]string, activate bool) (int, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, envID, contentTypeID)
+
+	// The content type is handled as raw JSON, since contentful-go has no
+	// notion of newId.
+	var ct map[string]interface{}
+	if err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &ct); err != nil {
+		return 0, err
+	}
+
+	fields, _ := ct["fields"].([]interface{})
+	for _, raw := range fields {
+		field, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if id, ok := field["id"].(string); ok && renames[id] != "" {
+			field["newId"] = renames[id]
+		}
+	}
+
+	version := rawVersion(ct)
+	delete(ct, "sys")
+
+	var updated map[string]interface{}
+	if err := cmaRequest(client, http.MethodPut, path, nil, version, ct, &updated); err != nil {
+		return 0, err
+	}
+
+	if !activate {
+		return rawVersion(updated), nil
+	}
+
+	var published map[string]interface{}
+	if err := cmaRequest(client, http.MethodPut, path+"/published", nil, rawVersion(updated), nil, &published); err != nil {
+		return 0, err
+	}
+
+	return rawVersion(published), nil
+}
+
+// renamedFields returns copies of the fields with the IDs they get from
+// renames, by previous ID.
+func renamedFields(fields []*contentful.Field, renames map[string]string) []*contentful.Field {
+	renamed := make([]*contentful.Field, 0, len(fields))
+	for _, field := range fields {
+		copied := *field
+		if id, ok := renames[field.ID]; ok {
+			copied.ID = id
+		}
+
+		renamed = append(renamed, &copied)
+	}
+
+	return renamed
+}
+
+// rawVersion returns sys.version of an object decoded as raw JSON.
+func rawVersion(object map[string]interface{}) int {
+	sys, _ := object["sys"].(map[string]interface{})
+	version, _ := sys["version"].(float64)
+
+	return int(version)
+}
+
+// keepFieldOrder sorts fields in the order they have in the current content
+// type. Fields the content type does not have yet are added at the end.
+func keepFieldOrder(fields []*contentful.Field, current []*contentful.Field) []*contentful.Field {
+	position := map[string]int{}
+	for i, field := range current {
+		position[field.ID] = i
+	}
+
+	ordered := make([]*contentful.Field, len(fields))
+	copy(ordered, fields)
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		pi, ok := position[ordered[i].ID]
+		if !ok {
+			pi = len(current)
+		}
+
+		pj, ok := position[ordered[j].ID]
+		if !ok {
+			pj = len(current)
+		}
+
+		return pi < pj
+	})
+
+	return ordered
+}
+
+// suppressFieldReorder hides a diff of the field list that only changes the
+// order of the fields, unless reorder_fields is set.
+func suppressFieldReorder(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("reorder_fields").(bool) {
+		return false
+	}
+
+	o, n := d.GetChange("field")
+	oldFields, newFields := o.([]interface{}), n.([]interface{})
+
+	if len(oldFields) != len(newFields) {
+		return false
+	}
+
+	matched := make([]bool, len(oldFields))
+	for _, newField := range newFields {
+		found := false
+
+		for i, oldField := range oldFields {
+			if !matched[i] && reflect.DeepEqual(oldField, newField) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkFieldDataLoss returns an error if any entry of the content type has a
+// value for one of the removed fields, since removing the field deletes it.
+func checkFieldDataLoss(client *contentful.Client, spaceID, envID, contentTypeID string, removedFields []*contentful.Field) error {
+	var affected []string
+
+	for _, field := range removedFields {
+		count, err := countEntriesWithField(client, spaceID, envID, contentTypeID, field.ID)
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			affected = append(affected, fmt.Sprintf("%s (%d entries)", field.ID, count))
+		}
+	}
+
+	if len(affected) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("removing fields from content type %s would delete their values in existing entries: %s. Set allow_data_loss = true to remove them anyway", contentTypeID, strings.Join(affected, ", "))
+}
+
+// countEntriesWithField returns the number of entries of the content type
+// that have a value for the field.
+func countEntriesWithField(client *contentful.Client, spaceID, envID, contentTypeID, fieldID string) (int, error) {
+	query := url.Values{
+		"content_type":                   {contentTypeID},
+		"fields." + fieldID + "[exists]": {"true"},
+		"limit":                          {"1"},
+	}
+
+	var entries cmaCollection
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, envID)
+	if err := cmaRequest(client, http.MethodGet, path, query, 0, nil, &entries); err != nil {
+		return 0, err
+	}
+
+	return entries.Total, nil
+}
+
 func processItems(fieldItems []interface{}) *contentful.FieldTypeArrayItem {
 	var items *contentful.FieldTypeArrayItem
 
@@ -2,6 +2,8 @@ package contentful
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -11,6 +13,50 @@ import (
 
 const envID = "staging"
 
+func init() {
+	resource.AddTestSweepers("contentful_contenttype", &resource.Sweeper{
+		Name:         "contentful_contenttype",
+		F:            testSweepContentTypes,
+		Dependencies: []string{"contentful_entry"},
+	})
+}
+
+func testSweepContentTypes(region string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+
+	env, err := sharedEnvironment(client)
+	if err != nil {
+		return err
+	}
+
+	var contentTypes []*contentful.ContentType
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types", spaceID, envID)
+	if err := cmaListAll(client, path, nil, &contentTypes); err != nil {
+		return err
+	}
+
+	for _, ct := range contentTypes {
+		if !isTestAccObject(ct.Sys.ID) && !isTestAccObject(ct.Name) {
+			continue
+		}
+
+		if ct.Sys.PublishedVersion != 0 {
+			if err := client.ContentTypes.Deactivate(env, ct); err != nil {
+				return err
+			}
+		}
+
+		if err := client.ContentTypes.Delete(env, ct); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccContentfulContentType_Basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -20,28 +66,589 @@ func TestAccContentfulContentType_Basic(t *testing.T) {
 			{
 				Config: testAccContentfulContentTypeConfig,
 				Check: resource.TestCheckResourceAttr(
-					"contentful_contenttype.mycontenttype", "name", "tf_test1"),
+					"contentful_contenttype.mycontenttype", "name", "tf-acc-test-1"),
 			},
 			{
 				Config: testAccContentfulContentTypeUpdateConfig,
 				Check: resource.TestCheckResourceAttr(
-					"contentful_contenttype.mycontenttype", "name", "tf_test1"),
+					"contentful_contenttype.mycontenttype", "name", "tf-acc-test-1"),
 			},
 			{
 				Config: testAccContentfulContentTypeLinkConfig,
 				Check: resource.TestCheckResourceAttr(
-					"contentful_contenttype.mylinked_contenttype", "name", "tf_linked"),
+					"contentful_contenttype.mylinked_contenttype", "name", "tf-acc-test-linked"),
 			},
 			{
 				Config: testAccContentfulContentTypeWithID,
 				Check: resource.TestCheckResourceAttr(
-					"contentful_contenttype.content_type_with_id", "name", "tf_test_with_id"),
+					"contentful_contenttype.content_type_with_id", "name", "tf-acc-test-with-id"),
 			},
 		},
 	})
 }
 
 // noinspection GoUnusedFunction
+func TestContentfulContentType_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_contenttype", "/spaces/%s/environments/master/content_types/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulContentTypeConfig("Field 2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "name", "tf-acc-test-1"),
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "version", "2"),
+					resource.TestCheckResourceAttrPair("contentful_contenttype.mycontenttype", "content_type_id", "contentful_contenttype.mycontenttype", "id"),
+				),
+			},
+			{
+				Config: testFakeContentfulContentTypeConfig("Field 2 updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "version", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulContentType_FieldRemoval(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_contenttype", "/spaces/%s/environments/master/content_types/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulContentTypeFieldRemovalConfig(true, false),
+			},
+			{
+				Config:      testFakeContentfulContentTypeFieldRemovalConfig(false, false),
+				ExpectError: regexp.MustCompile(`field2 \(1 entries\)`),
+			},
+			{
+				Config: testFakeContentfulContentTypeFieldRemovalConfig(false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "field.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulContentType_FieldRenameAndReorder(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	field1 := `
+  field {
+    id   = "field1"
+    name = "Field 1"
+    type = "Text"
+  }`
+	field2 := `
+  field {
+    id   = "field2"
+    name = "Field 2"
+    type = "Text"
+  }`
+	renamed := `
+  field {
+    id          = "renamed"
+    previous_id = "field2"
+    name        = "Field 2"
+    type        = "Text"
+  }`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_contenttype", "/spaces/%s/environments/master/content_types/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulContentTypeFieldsConfig(field1+field2, true),
+				Check:  testFakeCreateEntry(fake, "tf-acc-test-entry", "mycontenttype", "field2", "Kept after rename"),
+			},
+			{
+				Config: testFakeContentfulContentTypeFieldsConfig(field1+renamed, true),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeCheckContentTypeFieldIDs(fake, "mycontenttype", "field1", "renamed"),
+					testFakeCheckEntryField(fake, "tf-acc-test-entry", "renamed", "Kept after rename"),
+				),
+			},
+			{
+				Config: testFakeContentfulContentTypeFieldsConfig(renamed+field1, false),
+				Check:  testFakeCheckContentTypeFieldIDs(fake, "mycontenttype", "field1", "renamed"),
+			},
+			{
+				Config: testFakeContentfulContentTypeFieldsConfig(renamed+field1, true),
+				Check:  testFakeCheckContentTypeFieldIDs(fake, "mycontenttype", "renamed", "field1"),
+			},
+		},
+	})
+}
+
+func TestContentfulContentType_RenameWithRefusedRemoval(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	field1 := `
+  field {
+    id   = "field1"
+    name = "Field 1"
+    type = "Text"
+  }`
+	field2 := `
+  field {
+    id   = "field2"
+    name = "Field 2"
+    type = "Text"
+  }`
+	renamed := `
+  field {
+    id          = "renamed"
+    previous_id = "field2"
+    name        = "Field 2"
+    type        = "Text"
+  }`
+	field3 := `
+  field {
+    id   = "field3"
+    name = "Field 3"
+    type = "Text"
+  }`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_contenttype", "/spaces/%s/environments/master/content_types/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulContentTypeFieldsConfig(field1+field2+field3, false),
+				Check:  testFakeCreateEntry(fake, "tf-acc-test-entry", "mycontenttype", "field3", "Would be lost"),
+			},
+			{
+				// Removing field3 is refused before field2 is renamed.
+				Config:      testFakeContentfulContentTypeFieldsConfig(field1+renamed, false),
+				ExpectError: regexp.MustCompile(`field3 \(1 entries\)`),
+			},
+			{
+				Config: testFakeContentfulContentTypeFieldsConfig(field1+field2+field3, false),
+				Check:  testFakeCheckContentTypeFieldIDs(fake, "mycontenttype", "field1", "field2", "field3"),
+			},
+		},
+	})
+}
+
+// testFakeCreateEntry stores an entry with a single value directly in the
+// fake, as if it had been created in the web app.
+func testFakeCreateEntry(fake *fakeCMA, entryID, contentTypeID, fieldID, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fake.put(fmt.Sprintf("/spaces/%s/environments/master/entries/%s", fakeSpaceID, entryID), map[string]interface{}{
+			"sys": map[string]interface{}{
+				"id":          entryID,
+				"type":        "Entry",
+				"version":     1,
+				"contentType": fakeLink("ContentType", contentTypeID),
+			},
+			"fields": map[string]interface{}{
+				fieldID: map[string]interface{}{"en-US": content},
+			},
+		})
+
+		return nil
+	}
+}
+
+func testFakeCheckEntryField(fake *fakeCMA, entryID, fieldID, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		entry := fake.get(fmt.Sprintf("/spaces/%s/environments/master/entries/%s", fakeSpaceID, entryID))
+		fields, _ := entry["fields"].(map[string]interface{})
+		value, _ := fields[fieldID].(map[string]interface{})
+
+		if value["en-US"] != content {
+			return fmt.Errorf("expected %s of entry %s to be %q, got %v", fieldID, entryID, content, fields)
+		}
+
+		return nil
+	}
+}
+
+func testFakeCheckContentTypeFieldIDs(fake *fakeCMA, contentTypeID string, fieldIDs ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ct := fake.get(fmt.Sprintf("/spaces/%s/environments/master/content_types/%s", fakeSpaceID, contentTypeID))
+
+		var ids []string
+		for _, field := range ct["fields"].([]interface{}) {
+			ids = append(ids, field.(map[string]interface{})["id"].(string))
+		}
+
+		if strings.Join(ids, ",") != strings.Join(fieldIDs, ",") {
+			return fmt.Errorf("expected fields %v, got %v", fieldIDs, ids)
+		}
+
+		return nil
+	}
+}
+
+func TestContentfulContentType_Draft(t *testing.T) {
+	fake := newFakeCMA(t)
+	path := fmt.Sprintf("/spaces/%s/environments/master/content_types/mycontenttype", fakeSpaceID)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_contenttype", "/spaces/%s/environments/master/content_types/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulContentTypeActiveConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "published_version", "0"),
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "has_unpublished_changes", "true"),
+				),
+			},
+			{
+				Config: testFakeContentfulContentTypeActiveConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "version", "3"),
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "published_version", "2"),
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "has_unpublished_changes", "false"),
+				),
+			},
+			{
+				// A draft change made in the web app is activated again.
+				PreConfig: func() {
+					ct := fake.get(path)
+					ct["name"] = "Changed in the web app"
+					fakeSys(ct)["version"] = fakeVersion(ct) + 1
+					fake.put(path, ct)
+				},
+				Config: testFakeContentfulContentTypeActiveConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "published_version", "5"),
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "has_unpublished_changes", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulContentType_DraftRemoval(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	config := func(active bool, field2 string) string {
+		return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  active = %t
+  field {
+    id   = "field1"
+    name = "Field 1"
+    type = "Text"
+  }
+  %s
+}
+`, fakeSpaceID, active, field2)
+	}
+	field2 := `field {
+    id   = "field2"
+    name = "Field 2"
+    type = "Text"
+  }`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_contenttype", "/spaces/%s/environments/master/content_types/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: config(true, field2),
+			},
+			{
+				// The removed field stays omitted in the draft.
+				Config: config(false, ""),
+				Check:  testFakeCheckContentTypeFieldIDs(fake, "mycontenttype", "field1", "field2"),
+			},
+			{
+				Config: config(true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeCheckContentTypeFieldIDs(fake, "mycontenttype", "field1"),
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "has_unpublished_changes", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulContentType_DraftRename(t *testing.T) {
+	fake := newFakeCMA(t)
+	path := fmt.Sprintf("/spaces/%s/environments/master/content_types/mycontenttype", fakeSpaceID)
+
+	config := func(field2ID, previousID string, active bool) string {
+		return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  active = %t
+  field {
+    id   = "field1"
+    name = "Field 1"
+    type = "Text"
+  }
+  field {
+    id          = "%s"
+    previous_id = "%s"
+    name        = "Field 2"
+    type        = "Text"
+  }
+}
+`, fakeSpaceID, active, field2ID, previousID)
+	}
+
+	var publishedVersion int
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_contenttype", "/spaces/%s/environments/master/content_types/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: config("field2", "", true),
+				Check: func(s *terraform.State) error {
+					publishedVersion = fakeInt(fakeSys(fake.get(path))["publishedVersion"])
+					return nil
+				},
+			},
+			{
+				// The rename stays in the draft.
+				Config: config("renamed", "field2", false),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeCheckContentTypeFieldIDs(fake, "mycontenttype", "field1", "renamed"),
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "has_unpublished_changes", "true"),
+					func(s *terraform.State) error {
+						if version := fakeInt(fakeSys(fake.get(path))["publishedVersion"]); version != publishedVersion {
+							return fmt.Errorf("draft rename was activated: published version %d, expected %d", version, publishedVersion)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config: config("renamed", "field2", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "has_unpublished_changes", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulContentType_DefinitionJSON(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	definition := `{
+  "sys": {"id": "exported", "version": 7},
+  "name": "tf-acc-test-1",
+  "displayField": "title",
+  "fields": [
+    {"id": "title", "name": "Title", "type": "Symbol", "required": true, "validations": [{"unique": true}, {"prohibitRegexp": {"pattern": "^draft", "flags": "i"}}]},
+    {"id": "author", "name": "Author", "type": "Link", "linkType": "Entry"}
+  ]
+}`
+	reordered := `{
+  "fields": [
+    {"type": "Symbol", "validations": [{"unique": true}, {"prohibitRegexp": {"flags": "i", "pattern": "^draft"}}], "name": "Title", "id": "title", "required": true},
+    {"linkType": "Entry", "type": "Link", "name": "Author", "id": "author"}
+  ],
+  "displayField": "title",
+  "sys": {"version": 8, "id": "exported"},
+  "name": "tf-acc-test-1"
+}`
+	defaultValue := `{
+  "fields": [
+    {"id": "title", "name": "Title", "type": "Symbol", "defaultValue": {"en-US": "Untitled"}}
+  ]
+}`
+	otherName := `{
+  "name": "Exported",
+  "fields": [
+    {"id": "title", "name": "Title", "type": "Symbol"}
+  ]
+}`
+	withoutAuthor := `{
+  "displayField": "title",
+  "fields": [
+    {"id": "title", "name": "Title", "type": "Symbol", "required": true}
+  ]
+}`
+	invalid := `{
+  "displayField": "author",
+  "fields": [
+    {"id": "author", "name": "Author", "type": "Link", "linkType": "Entry"}
+  ]
+}`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_contenttype", "/spaces/%s/environments/master/content_types/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testFakeContentfulContentTypeDefinitionConfig(invalid),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be of type Symbol or Text"),
+			},
+			{
+				Config:      testFakeContentfulContentTypeDefinitionConfig(defaultValue),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("field 0 of definition_json: defaultValue cannot be managed by the provider"),
+			},
+			{
+				Config:      testFakeContentfulContentTypeDefinitionConfig(otherName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`the name "Exported" in definition_json does not match`),
+			},
+			{
+				Config: testFakeContentfulContentTypeDefinitionConfig(definition),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeCheckContentTypeFieldIDs(fake, "mycontenttype", "title", "author"),
+					testFakeCheckContentTypeDisplayField(fake, "mycontenttype", "title"),
+					func(s *terraform.State) error {
+						ct := fake.get(fmt.Sprintf("/spaces/%s/environments/master/content_types/mycontenttype", fakeSpaceID))
+						title := ct["fields"].([]interface{})[0].(map[string]interface{})
+
+						if validations := title["validations"].([]interface{}); len(validations) != 2 {
+							return fmt.Errorf("expected the validations of title to be kept, got %v", validations)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config:   testFakeContentfulContentTypeDefinitionConfig(reordered),
+				PlanOnly: true,
+			},
+			{
+				// A field renamed in the web app shows up in the plan.
+				PreConfig: func() {
+					path := fmt.Sprintf("/spaces/%s/environments/master/content_types/mycontenttype", fakeSpaceID)
+					ct := fake.get(path)
+					ct["fields"].([]interface{})[1].(map[string]interface{})["name"] = "Writer"
+					fake.put(path, ct)
+				},
+				Config:             testFakeContentfulContentTypeDefinitionConfig(definition),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testFakeContentfulContentTypeDefinitionConfig(withoutAuthor),
+				Check:  testFakeCheckContentTypeFieldIDs(fake, "mycontenttype", "title"),
+			},
+		},
+	})
+}
+
+func testFakeCheckContentTypeDisplayField(fake *fakeCMA, contentTypeID, displayField string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ct := fake.get(fmt.Sprintf("/spaces/%s/environments/master/content_types/%s", fakeSpaceID, contentTypeID))
+
+		if ct["displayField"] != displayField {
+			return fmt.Errorf("expected display field %s, got %v", displayField, ct["displayField"])
+		}
+
+		return nil
+	}
+}
+
+func TestParseContentTypeDefinition(t *testing.T) {
+	cases := []struct {
+		name       string
+		field      string
+		normalized string
+		err        string
+	}{
+		{
+			"rich text validations",
+			`{"id": "body", "name": "Body", "type": "RichText", "validations": [{"enabledMarks": ["bold"]}, {"nodes": {"embedded-entry-block": [{"size": {"max": 2}}]}}]}`,
+			`{"fields":[{"id":"body","name":"Body","type":"RichText","validations":[{"enabledMarks":["bold"]},{"nodes":{"embedded-entry-block":[{"size":{"max":2}}]}}]}]}`,
+			"",
+		},
+		{
+			"array of links",
+			`{"id": "images", "name": "Images", "type": "Array", "items": {"type": "Link", "linkType": "Asset", "validations": [{"linkMimetypeGroup": ["image"]}]}}`,
+			`{"fields":[{"id":"images","name":"Images","type":"Array","items":{"type":"Link","validations":[{"linkMimetypeGroup":["image"]}],"linkType":"Asset"}}]}`,
+			"",
+		},
+		{"default value", `{"id": "title", "name": "Title", "type": "Symbol", "defaultValue": {"en-US": "Untitled"}}`, "", "defaultValue cannot be managed"},
+		{"unknown items property", `{"id": "tags", "name": "Tags", "type": "Array", "items": {"type": "Symbol", "unique": true}}`, "", "items.unique cannot be managed"},
+		{"wrong type", `{"id": "title", "name": "Title", "type": "Symbol", "required": "yes"}`, "", "required must be a boolean"},
+		{"validation that is not an object", `{"id": "title", "name": "Title", "type": "Symbol", "validations": ["unique"]}`, "", "validations must be a list of objects"},
+	}
+
+	for _, c := range cases {
+		definition, err := parseContentTypeDefinition(`{"fields": [` + c.field + `]}`)
+
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", c.name, c.err, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+
+		if normalized := marshalContentTypeDefinition(definition); normalized != c.normalized {
+			t.Errorf("%s: expected %s, got %s", c.name, c.normalized, normalized)
+		}
+	}
+}
+
+func TestValidateContentTypeFields(t *testing.T) {
+	field := func(id, fieldType, linkType string, items ...interface{}) interface{} {
+		return map[string]interface{}{
+			"id":        id,
+			"type":      fieldType,
+			"link_type": linkType,
+			"items":     items,
+		}
+	}
+	linkItems := map[string]interface{}{"type": "Link", "link_type": "Asset"}
+
+	cases := []struct {
+		name         string
+		displayField string
+		fields       []interface{}
+		err          string
+	}{
+		{"valid", "title", []interface{}{field("title", "Symbol", ""), field("images", "Array", "", linkItems), field("author", "Link", "Entry")}, ""},
+		{"unknown display field", "name", []interface{}{field("title", "Symbol", "")}, "does not match any field"},
+		{"display field type", "count", []interface{}{field("count", "Integer", "")}, "must be of type Symbol or Text"},
+		{"duplicate ID", "title", []interface{}{field("title", "Symbol", ""), field("title", "Text", "")}, "more than one field"},
+		{"link_type on Symbol", "title", []interface{}{field("title", "Symbol", "Entry")}, "requires type Link or Array"},
+		{"Link without link_type", "title", []interface{}{field("title", "Symbol", ""), field("author", "Link", "")}, "needs a link_type"},
+		{"items on Symbol", "title", []interface{}{field("title", "Symbol", "", linkItems)}, "items require type Array"},
+		{"Array without items", "title", []interface{}{field("title", "Symbol", ""), field("images", "Array", "")}, "needs items"},
+	}
+
+	for _, c := range cases {
+		err := validateContentTypeFields(c.displayField, c.fields)
+
+		if c.err == "" && err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+		}
+
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%s: expected an error containing %q, got %v", c.name, c.err, err)
+		}
+	}
+}
+
 func testAccCheckContentfulContentTypeExists(n string, contentType *contentful.ContentType) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -131,7 +738,7 @@ var testAccContentfulContentTypeConfig = `
 resource "contentful_contenttype" "mycontenttype" {
   space_id = "` + spaceID + `"
 	env_id = "` + envID + `"
-  name = "tf_test1"
+  name = "tf-acc-test-1"
   description = "Terraform Acc Test Content Type"
   display_field = "field1"
   field {
@@ -159,7 +766,7 @@ var testAccContentfulContentTypeUpdateConfig = `
 resource "contentful_contenttype" "mycontenttype" {
   space_id = "` + spaceID + `"
 	env_id = "` + envID + `"
-  name = "tf_test1"
+  name = "tf-acc-test-1"
   description = "Terraform Acc Test Content Type description change"
 	display_field = "field1"
   field {
@@ -187,10 +794,10 @@ var testAccContentfulContentTypeLinkConfig = `
 resource "contentful_contenttype" "mycontenttype" {
   space_id = "` + spaceID + `"
 	env_id = "` + envID + `"
-  name = "tf_test1"
+  name = "tf-acc-test-1"
   description = "Terraform Acc Test Content Type description change"
   display_field = "field1"
-	content_type_id = "tf_test1"
+	content_type_id = "tf-acc-test-1"
   field {
 		disabled  = false
 		id        = "field1"
@@ -214,9 +821,15 @@ resource "contentful_contenttype" "mycontenttype" {
 resource "contentful_contenttype" "mylinked_contenttype" {
   space_id = "` + spaceID + `"
 	env_id = "` + envID + `"
-  name          = "tf_linked"
+  name          = "tf-acc-test-linked"
   description   = "Terraform Acc Test Content Type with links"
-  display_field = "entry_link_field"
+  display_field = "title"
+	field {
+    id       = "title"
+    name     = "Title"
+    type     = "Symbol"
+    required = true
+  }
 	field {
     id   = "asset_field"
     name = "Asset Field"
@@ -235,7 +848,7 @@ resource "contentful_contenttype" "mylinked_contenttype" {
     validations = [
 			jsonencode({
 				linkContentType = [
-					"tf_test1"
+					"tf-acc-test-1"
 				]
 			})
 		]
@@ -248,7 +861,7 @@ var testAccContentfulContentTypeWithID = `
 resource "contentful_contenttype" "content_type_with_id" {
   space_id = "` + spaceID + `"
 	env_id = "` + envID + `"
-  name = "tf_test_with_id"
+  name = "tf-acc-test-with-id"
   description = "Content Type with ID"
 	content_type_id = "contentTypeWithID"
   display_field = "field1"
@@ -272,3 +885,117 @@ resource "contentful_contenttype" "content_type_with_id" {
   }	
 }
 `
+
+func testFakeContentfulContentTypeConfig(fieldName string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%s"
+  env_id = "master"
+  name = "tf-acc-test-1"
+  description = "Terraform Acc Test Content Type"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    required  = true
+    type      = "Text"
+  }
+  field {
+    id        = "field2"
+    name      = "%s"
+    type      = "Integer"
+  }
+}
+`, fakeSpaceID, fieldName)
+}
+
+func testFakeContentfulContentTypeFieldRemovalConfig(withField2, allowDataLoss bool) string {
+	field2 := ""
+	if withField2 {
+		field2 = `
+  field {
+    id        = "field2"
+    name      = "Field 2"
+    type      = "Text"
+  }`
+	}
+
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  allow_data_loss = %[2]t
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }%[3]s
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id = "tf-acc-test-entry"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = "mycontenttype"
+  locale = "en-US"
+  field {
+    id = "field2"
+    content = "Kept in field2"
+    locale = "en-US"
+  }
+  published = false
+  archived  = false
+
+  depends_on = [contentful_contenttype.mycontenttype]
+}
+`, fakeSpaceID, allowDataLoss, field2)
+}
+
+func testFakeContentfulContentTypeFieldsConfig(fields string, reorderFields bool) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  reorder_fields = %t
+%s
+}
+`, fakeSpaceID, reorderFields, fields)
+}
+
+func testFakeContentfulContentTypeActiveConfig(active bool) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  active = %t
+  field {
+    id   = "field1"
+    name = "Field 1"
+    type = "Text"
+  }
+}
+`, fakeSpaceID, active)
+}
+
+func testFakeContentfulContentTypeDefinitionConfig(definition string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  definition_json = <<EOT
+%s
+EOT
+}
+`, fakeSpaceID, definition)
+}
@@ -0,0 +1,347 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// remoteEntry is an entry as returned by the Content Management API, with its
+// field values kept as they are.
+type remoteEntry struct {
+	Sys    *contentful.Sys                   `json:"sys"`
+	Fields map[string]map[string]interface{} `json:"fields"`
+}
+
+func resourceContentfulEntries() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateEntries,
+		Read:   resourceReadEntries,
+		Update: resourceUpdateEntries,
+		Delete: resourceDeleteEntries,
+
+		CustomizeDiff: resourceEntriesCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The entries by ID, each with a content_type and fields by ID
+			// and locale. Refreshing reads the document back from
+			// Contentful, so changes made in the web app show up in the plan.
+			"document_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEntriesDocument,
+				StateFunc:    normalizeEntriesDocument,
+			},
+			"published": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"force_overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"versions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"statuses": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCreateEntries(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	entries, err := parseEntriesDocument(d.Get("document_json").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+
+	err = applyEntriesDocument(d, client, nil, entries, nil, d.Timeout(schema.TimeoutCreate))
+
+	return readEntriesAfter(d, client, sortedDocumentIDs(entries), err)
+}
+
+func resourceUpdateEntries(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	oldDocument, newDocument := d.GetChange("document_json")
+
+	old, err := parseEntriesDocument(oldDocument.(string))
+	if err != nil {
+		return err
+	}
+
+	entries, err := parseEntriesDocument(newDocument.(string))
+	if err != nil {
+		return err
+	}
+
+	// The planned versions are unknown, so the ones last read are used.
+	versions, _ := d.GetChange("versions")
+
+	err = applyEntriesDocument(d, client, old, entries, versions.(map[string]interface{}), d.Timeout(schema.TimeoutUpdate))
+
+	// Entries removed from the document stay tracked until they are gone.
+	ids := sortedDocumentIDs(entries)
+	for id := range old {
+		if _, ok := entries[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return readEntriesAfter(d, client, ids, err)
+}
+
+func resourceReadEntries(d *schema.ResourceData, m interface{}) (err error) {
+	var ids []string
+	for id := range d.Get("versions").(map[string]interface{}) {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return readEntries(d, m.(*contentful.Client), ids)
+}
+
+func resourceDeleteEntries(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	var ids []string
+	for id := range d.Get("versions").(map[string]interface{}) {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if err = deletePublishable(client, "entry", entriesPath(d, id), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceEntriesCustomizeDiff plans an update when an entry does not have the
+// requested status, for example after it was unpublished in the web app. The
+// versions and statuses are unknown until any planned update is applied.
+func resourceEntriesCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	status := statusDraft
+	if d.Get("published").(bool) {
+		status = statusPublished
+	}
+
+	drifted := false
+	for _, current := range d.Get("statuses").(map[string]interface{}) {
+		if current.(string) != status {
+			drifted = true
+		}
+	}
+
+	if !drifted && len(d.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+
+	if err := d.SetNewComputed("statuses"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("versions")
+}
+
+// applyEntriesDocument makes the entries in Contentful match the document:
+// entries that are new are created, entries that differ from the old document
+// are updated with the version last read, and entries that were removed are
+// deleted. Entries that did not change are left alone. Finally, every entry of
+// the document is published or unpublished as requested.
+func applyEntriesDocument(d *schema.ResourceData, client *contentful.Client, old, entries map[string]*documentEntry, versions map[string]interface{}, timeout time.Duration) error {
+	created, updated, deleted := changedDocumentEntries(old, entries)
+
+	// Deleted first, since an entry that moves to another content type is
+	// deleted and created again with the same ID.
+	for _, id := range deleted {
+		if err := deletePublishable(client, "entry", entriesPath(d, id), timeout); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range created {
+		if err := upsertDocumentEntry(client, entriesPath(d, id), entries[id], 0); err != nil {
+			return fmt.Errorf("creating entry %s: %s", id, err)
+		}
+	}
+
+	for _, id := range updated {
+		version, _ := versions[id].(int)
+
+		err := upsertDocumentEntry(client, entriesPath(d, id), entries[id], version)
+		if isVersionMismatch(err) {
+			var remote remoteEntry
+			if getErr := cmaRequest(client, http.MethodGet, entriesPath(d, id), nil, 0, nil, &remote); getErr != nil {
+				return getErr
+			}
+
+			if !d.Get("force_overwrite").(bool) {
+				return &versionConflictError{
+					objectType:    "entry",
+					id:            id,
+					version:       version,
+					remoteVersion: remote.Sys.Version,
+					fields:        changedLocalizedFields(documentFieldValues(old[id].Fields), documentFieldValues(remote.Fields)),
+				}
+			}
+
+			err = upsertDocumentEntry(client, entriesPath(d, id), entries[id], remote.Sys.Version)
+		}
+
+		if err != nil {
+			return fmt.Errorf("updating entry %s: %s", id, err)
+		}
+	}
+
+	return publishDocumentEntries(d, client, sortedDocumentIDs(entries), timeout)
+}
+
+// publishDocumentEntries publishes or unpublishes the entries with a bulk
+// action. Archived entries are unarchived first, one by one.
+func publishDocumentEntries(d *schema.ResourceData, client *contentful.Client, ids []string, timeout time.Duration) error {
+	published := d.Get("published").(bool)
+
+	var remote []*remoteEntry
+	if err := cmaListByIDs(client, entriesPath(d, ""), ids, &remote); err != nil {
+		return err
+	}
+
+	var links []*link
+	for _, entry := range remote {
+		status := publishStatus(entry.Sys)
+
+		if status == statusArchived {
+			if _, err := setPublishState(client, "entry", entriesPath(d, entry.Sys.ID), published, false); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if published && (status == statusDraft || status == statusChanged) || !published && (status == statusPublished || status == statusChanged) {
+			versioned := newLink("Entry", entry.Sys.ID)
+			versioned.Sys.Version = entry.Sys.Version
+			links = append(links, versioned)
+		}
+	}
+
+	action := "publish"
+	if !published {
+		action = "unpublish"
+	}
+
+	return runBulkActions(client, d, action, links, timeout)
+}
+
+// upsertDocumentEntry creates the entry at path, or updates it when a version
+// is given.
+func upsertDocumentEntry(client *contentful.Client, path string, entry *documentEntry, version int) error {
+	fields := entry.Fields
+	if fields == nil {
+		fields = map[string]map[string]interface{}{}
+	}
+
+	headers := map[string]string{"X-Contentful-Content-Type": entry.ContentType}
+	body := map[string]interface{}{"fields": fields}
+
+	return doCMARequest(client, http.MethodPut, path, nil, version, headers, body, nil)
+}
+
+// readEntriesAfter records the entries as they are in Contentful after an
+// apply, also when it failed halfway, and returns the error of the apply.
+func readEntriesAfter(d *schema.ResourceData, client *contentful.Client, ids []string, applyErr error) error {
+	if err := readEntries(d, client, ids); err != nil && applyErr == nil {
+		return err
+	}
+
+	return applyErr
+}
+
+// readEntries sets the document, versions and statuses of the entries with the
+// given IDs that exist in Contentful. Entries that were deleted are left out,
+// so that they are created again.
+func readEntries(d *schema.ResourceData, client *contentful.Client, ids []string) error {
+	var remote []*remoteEntry
+	if err := cmaListByIDs(client, entriesPath(d, ""), ids, &remote); err != nil {
+		return err
+	}
+
+	document := map[string]*documentEntry{}
+	versions := map[string]interface{}{}
+	statuses := map[string]interface{}{}
+
+	for _, entry := range remote {
+		documented := &documentEntry{
+			ContentType: entry.Sys.ContentType.Sys.ID,
+			Fields:      entry.Fields,
+		}
+
+		dropEmptyFields(documented)
+
+		document[entry.Sys.ID] = documented
+		versions[entry.Sys.ID] = entry.Sys.Version
+		statuses[entry.Sys.ID] = publishStatus(entry.Sys)
+	}
+
+	if err := d.Set("document_json", marshalEntriesDocument(document)); err != nil {
+		return err
+	}
+
+	if err := d.Set("versions", versions); err != nil {
+		return err
+	}
+
+	return d.Set("statuses", statuses)
+}
+
+// entriesPath returns the path of the entry with the given ID in the space and
+// environment of d, or of the entries collection for an empty ID.
+func entriesPath(d *schema.ResourceData, id string) string {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries", d.Get("space_id").(string), d.Get("env_id").(string))
+	if id == "" {
+		return path
+	}
+
+	return path + "/" + id
+}
@@ -0,0 +1,199 @@
+package contentful
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccContentfulEntries_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulEntriesConfig("Germany"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entries.myentries", "versions.%", "2"),
+					resource.TestCheckResourceAttr("contentful_entries.myentries", "statuses.tf-acc-test-germany", "published"),
+				),
+			},
+			{
+				Config: testAccContentfulEntriesConfig("Deutschland"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entries.myentries", "statuses.tf-acc-test-germany", "published"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulEntries_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+	entriesPath := fmt.Sprintf("/spaces/%s/environments/master/entries/", fakeSpaceID)
+
+	updated := `{
+  "tf-acc-test-europe": {"content_type": "region", "fields": {"name": {"en-US": "Europe"}}},
+  "tf-acc-test-france": {
+    "content_type": "country",
+    "fields": {
+      "name": {"en-US": "France"},
+      "region": {"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "tf-acc-test-europe"}}}
+    }
+  }
+}`
+
+	var europeVersion int
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckEntriesDestroy(fake, entriesPath+"tf-acc-test-europe", entriesPath+"tf-acc-test-germany", entriesPath+"tf-acc-test-france"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testFakeContentfulEntriesConfig(`{"tf-acc-test-spain": {"fields": {"name": {"en-US": "Spain"}}}}`),
+				ExpectError: regexp.MustCompile("entry tf-acc-test-spain of document_json has no content_type"),
+			},
+			{
+				Config: testFakeContentfulEntriesConfig(`{
+  "tf-acc-test-europe": {"content_type": "region", "fields": {"name": {"en-US": "Europe"}}},
+  "tf-acc-test-germany": {
+    "content_type": "country",
+    "fields": {
+      "name": {"en-US": "Germany", "de-DE": "Deutschland"},
+      "region": {"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "tf-acc-test-europe"}}}
+    }
+  }
+}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entries.myentries", "versions.%", "2"),
+					resource.TestCheckResourceAttr("contentful_entries.myentries", "statuses.tf-acc-test-europe", "published"),
+					resource.TestCheckResourceAttr("contentful_entries.myentries", "statuses.tf-acc-test-germany", "published"),
+					testFakeCheckPublished(fake, entriesPath+"tf-acc-test-europe"),
+					testFakeCheckPublished(fake, entriesPath+"tf-acc-test-germany"),
+					func(s *terraform.State) error {
+						europeVersion = fakeVersion(fake.get(entriesPath + "tf-acc-test-europe"))
+						return nil
+					},
+				),
+			},
+			{
+				// Only the changed entry is updated, the removed one is
+				// deleted and the new one created.
+				Config: testFakeContentfulEntriesConfig(updated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entries.myentries", "versions.%", "2"),
+					resource.TestCheckNoResourceAttr("contentful_entries.myentries", "versions.tf-acc-test-germany"),
+					testFakeCheckPublished(fake, entriesPath+"tf-acc-test-france"),
+					func(s *terraform.State) error {
+						if fake.get(entriesPath+"tf-acc-test-germany") != nil {
+							return fmt.Errorf("removed entry tf-acc-test-germany still exists")
+						}
+
+						if version := fakeVersion(fake.get(entriesPath + "tf-acc-test-europe")); version != europeVersion {
+							return fmt.Errorf("unchanged entry tf-acc-test-europe was updated from version %d to %d", europeVersion, version)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				// Changes made in the web app are reverted and the entry is
+				// published again.
+				PreConfig: func() {
+					entry := fake.get(entriesPath + "tf-acc-test-europe")
+					entry["fields"] = map[string]interface{}{"name": map[string]interface{}{"en-US": "Europa"}}
+					fakeSys(entry)["version"] = fakeVersion(entry) + 1
+					fake.put(entriesPath+"tf-acc-test-europe", entry)
+				},
+				Config: testFakeContentfulEntriesConfig(updated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entries.myentries", "statuses.tf-acc-test-europe", "published"),
+					testFakeCheckEntryField(fake, "tf-acc-test-europe", "name", "Europe"),
+				),
+			},
+			{
+				// An entry unpublished in the web app is published again.
+				PreConfig: func() {
+					entry := fake.get(entriesPath + "tf-acc-test-france")
+					delete(fakeSys(entry), "publishedVersion")
+					delete(fakeSys(entry), "publishedAt")
+					fake.put(entriesPath+"tf-acc-test-france", entry)
+				},
+				Config: testFakeContentfulEntriesConfig(updated),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeCheckPublished(fake, entriesPath+"tf-acc-test-france"),
+				),
+			},
+		},
+	})
+}
+
+func testFakeCheckEntriesDestroy(fake *fakeCMA, paths ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, path := range paths {
+			if fake.get(path) != nil {
+				return fmt.Errorf("entry still exists at %s", path)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccContentfulEntriesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  name = "tf-acc-test-entries"
+  display_field = "name"
+  field {
+    id        = "name"
+    name      = "Name"
+    type      = "Symbol"
+  }
+  field {
+    id        = "region"
+    name      = "Region"
+    type      = "Link"
+    link_type = "Entry"
+  }
+}
+
+resource "contentful_entries" "myentries" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  document_json = jsonencode({
+    tf-acc-test-europe = {
+      content_type = contentful_contenttype.mycontenttype.id
+      fields = {
+        name = { "en-US" = "Europe" }
+      }
+    }
+    tf-acc-test-germany = {
+      content_type = contentful_contenttype.mycontenttype.id
+      fields = {
+        name   = { "en-US" = "%[3]s" }
+        region = { "en-US" = { sys = { type = "Link", linkType = "Entry", id = "tf-acc-test-europe" } } }
+      }
+    }
+  })
+}
+`, spaceID, envID, name)
+}
+
+func testFakeContentfulEntriesConfig(document string) string {
+	return fmt.Sprintf(`
+resource "contentful_entries" "myentries" {
+  space_id = "%s"
+  env_id = "master"
+  document_json = <<EOT
+%s
+EOT
+}
+`, fakeSpaceID, document)
+}
@@ -1,6 +1,10 @@
 package contentful
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	contentful "github.com/regressivetech/contentful-go"
 )
@@ -12,15 +16,53 @@ func resourceContentfulEntry() *schema.Resource {
 		Update: resourceUpdateEntry,
 		Delete: resourceDeleteEntry,
 
+		CustomizeDiff: resourceEntryCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"entry_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
 			},
 			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"published_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"published_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_published_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"archived_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"space_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -58,12 +100,33 @@ func resourceContentfulEntry() *schema.Resource {
 				},
 			},
 			"published": {
-				Type:     schema.TypeBool,
-				Required: true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the entry is published. Ignored when manage_publish_state is false.",
 			},
 			"archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the entry is archived. Ignored when manage_publish_state is false.",
+			},
+			"prevent_delete_if_referenced": {
 				Type:     schema.TypeBool,
-				Required: true,
+				Optional: true,
+				Default:  false,
+			},
+			"manage_publish_state": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"tags":     metadataLinksSchema(),
+			"concepts": metadataLinksSchema(),
+			"force_overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -95,7 +158,9 @@ func resourceCreateEntry(d *schema.ResourceData, m interface{}) (err error) {
 		},
 	}
 
-	err = client.Entries.Upsert(env, d.Get("contenttype_id").(string), entry)
+	err = retryRateLimited(d.Timeout(schema.TimeoutCreate), func() error {
+		return client.Entries.Upsert(env, d.Get("contenttype_id").(string), entry)
+	})
 	if err != nil {
 		return err
 	}
@@ -113,12 +178,22 @@ func resourceCreateEntry(d *schema.ResourceData, m interface{}) (err error) {
 	return err
 }
 
+// entryKeys are the attributes that are saved with the entry itself. Tags,
+// concepts and the publish state are applied separately.
+var entryKeys = []string{"space_id", "env_id", "contenttype_id", "locale", "field"}
+
 func resourceUpdateEntry(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Client)
 	spaceID := d.Get("space_id").(string)
 	entryID := d.Id()
 	envID := d.Get("env_id").(string)
 
+	// Saving the entry increases its version, so it is only saved when it
+	// changed.
+	if !hasChange(d, entryKeys...) {
+		return setEntryState(d, m)
+	}
+
 	// lookup the environment
 	env, err := client.Environments.Get(spaceID, envID)
 	if err != nil {
@@ -126,7 +201,7 @@ func resourceUpdateEntry(d *schema.ResourceData, m interface{}) (err error) {
 	}
 
 	// lookup the entry
-	entry, err := client.Entries.Get(env, entryID)
+	entry, err := getEntry(client, env, entryID)
 	if err != nil {
 		return err
 	}
@@ -142,7 +217,37 @@ func resourceUpdateEntry(d *schema.ResourceData, m interface{}) (err error) {
 	entry.Fields = fieldProperties
 	entry.Locale = d.Get("locale").(string)
 
-	err = client.Entries.Upsert(env, d.Get("contenttype_id").(string), entry)
+	// Update the version Terraform last read, so changes made in Contentful
+	// since then are not silently overwritten.
+	entry.Sys.Version = d.Get("version").(int)
+
+	err = retryRateLimited(d.Timeout(schema.TimeoutUpdate), func() error {
+		return client.Entries.Upsert(env, d.Get("contenttype_id").(string), entry)
+	})
+	if isVersionMismatch(err) {
+		remote, getErr := getEntry(client, env, entryID)
+		if getErr != nil {
+			return getErr
+		}
+
+		if !d.Get("force_overwrite").(bool) {
+			oldFields, _ := d.GetChange("field")
+
+			return &versionConflictError{
+				objectType:    "entry",
+				id:            entryID,
+				version:       entry.Sys.Version,
+				remoteVersion: remote.Sys.Version,
+				fields:        changedLocalizedFields(entryFieldValues(oldFields.([]interface{})), remoteEntryFieldValues(remote)),
+			}
+		}
+
+		entry.Sys.Version = remote.Sys.Version
+		err = retryRateLimited(d.Timeout(schema.TimeoutUpdate), func() error {
+			return client.Entries.Upsert(env, d.Get("contenttype_id").(string), entry)
+		})
+	}
+
 	if err != nil {
 		return err
 	}
@@ -160,33 +265,36 @@ func resourceUpdateEntry(d *schema.ResourceData, m interface{}) (err error) {
 	return err
 }
 
+func resourceEntryCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if err := customizePublishDiff("entry", d); err != nil {
+		return err
+	}
+
+	if err := customizeVersionDiff(d, append([]string{"tags", "concepts"}, entryKeys...)...); err != nil {
+		return err
+	}
+
+	return customizeEntryFieldsDiff(d, m.(*contentful.Client))
+}
+
 func setEntryState(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Client)
-	spaceID := d.Get("space_id").(string)
-	entryID := d.Id()
-	envID := d.Get("env_id").(string)
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", d.Get("space_id").(string), d.Get("env_id").(string), d.Id())
 
-	env, err := client.Environments.Get(spaceID, envID)
-
-	if err != nil {
+	if err = updateEntityMetadata(client, path, metadataLinksConfig(d, "tags", "concepts")); err != nil {
 		return err
 	}
 
-	entry, _ := client.Entries.Get(env, entryID)
-
-	if d.Get("published").(bool) && entry.Sys.PublishedAt == "" {
-		err = client.Entries.Publish(env, entry)
-	} else if !d.Get("published").(bool) && entry.Sys.PublishedAt != "" {
-		err = client.Entries.Unpublish(env, entry)
+	sys, err := applyPublishState(d, client, "entry", path)
+	if err != nil {
+		return err
 	}
 
-	if d.Get("archived").(bool) && entry.Sys.ArchivedAt == "" {
-		err = client.Entries.Archive(env, entry)
-	} else if !d.Get("archived").(bool) && entry.Sys.ArchivedAt != "" {
-		err = client.Entries.Unarchive(env, entry)
+	if err = d.Set("version", sys.Version); err != nil {
+		return err
 	}
 
-	return err
+	return setPublishMetadata(d, sys)
 }
 
 func resourceReadEntry(d *schema.ResourceData, m interface{}) (err error) {
@@ -200,13 +308,21 @@ func resourceReadEntry(d *schema.ResourceData, m interface{}) (err error) {
 		return err
 	}
 
-	entry, err := client.Entries.Get(env, entryID)
+	entry, err := getEntry(client, env, entryID)
 	if _, ok := err.(contentful.NotFoundError); ok {
 		d.SetId("")
 		return nil
 	}
 
-	return setEntryProperties(d, entry)
+	if err != nil {
+		return err
+	}
+
+	if err := setEntryProperties(d, entry); err != nil {
+		return err
+	}
+
+	return setEntityMetadata(d, client, fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", spaceID, envID, entryID), "tags", "concepts")
 }
 
 func resourceDeleteEntry(d *schema.ResourceData, m interface{}) (err error) {
@@ -215,17 +331,74 @@ func resourceDeleteEntry(d *schema.ResourceData, m interface{}) (err error) {
 	entryID := d.Id()
 	envID := d.Get("env_id").(string)
 
-	env, err := client.Environments.Get(spaceID, envID)
-	if err != nil {
-		return err
+	if d.Get("prevent_delete_if_referenced").(bool) {
+		references, total, err := entryReferences(client, spaceID, envID, entryID, 10)
+		if err != nil {
+			return err
+		}
+
+		if total > 0 {
+			return fmt.Errorf("entry %s is referenced by %d other entries (%s), remove the references or set prevent_delete_if_referenced = false", entryID, total, strings.Join(references, ", "))
+		}
 	}
 
-	_, err = client.Entries.Get(env, entryID)
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", spaceID, envID, entryID)
+
+	return deletePublishable(client, "entry", path, d.Timeout(schema.TimeoutDelete))
+}
+
+// getEntry wraps Entries.Get, which returns neither an entry nor an error when
+// the request fails.
+func getEntry(client *contentful.Client, env *contentful.Environment, entryID string) (*contentful.Entry, error) {
+	entry, err := client.Entries.Get(env, entryID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if entry == nil {
+		return nil, contentful.NotFoundError{}
+	}
+
+	return entry, nil
+}
+
+// entryFieldValues returns the field values of the entry as kept in state,
+// by field ID and locale.
+func entryFieldValues(rawField []interface{}) map[string]map[string]string {
+	values := map[string]map[string]string{}
+
+	for _, raw := range rawField {
+		field := raw.(map[string]interface{})
+		id := field["id"].(string)
+
+		if values[id] == nil {
+			values[id] = map[string]string{}
+		}
+
+		values[id][field["locale"].(string)] = field["content"].(string)
+	}
+
+	return values
+}
+
+// remoteEntryFieldValues returns the field values of the entry in Contentful,
+// by field ID and locale.
+func remoteEntryFieldValues(entry *contentful.Entry) map[string]map[string]string {
+	values := map[string]map[string]string{}
+
+	for id, raw := range entry.Fields {
+		localized, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		values[id] = map[string]string{}
+		for locale, value := range localized {
+			values[id][locale] = localizedString(value)
+		}
 	}
 
-	return client.Entries.Delete(env, entryID)
+	return values
 }
 
 func setEntryProperties(d *schema.ResourceData, entry *contentful.Entry) (err error) {
@@ -237,9 +410,17 @@ func setEntryProperties(d *schema.ResourceData, entry *contentful.Entry) (err er
 		return err
 	}
 
+	if err = setPublishMetadata(d, entry.Sys); err != nil {
+		return err
+	}
+
 	if err = d.Set("contenttype_id", entry.Sys.ContentType.Sys.ID); err != nil {
 		return err
 	}
 
+	if err = d.Set("entry_id", entry.Sys.ID); err != nil {
+		return err
+	}
+
 	return err
 }
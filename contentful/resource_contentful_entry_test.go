@@ -2,13 +2,67 @@ package contentful
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	contentful "github.com/regressivetech/contentful-go"
 )
 
+func init() {
+	resource.AddTestSweepers("contentful_entry", &resource.Sweeper{
+		Name: "contentful_entry",
+		F:    testSweepEntries,
+	})
+}
+
+func testSweepEntries(region string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+
+	env, err := sharedEnvironment(client)
+	if err != nil {
+		return err
+	}
+
+	var entries []*contentful.Entry
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, envID)
+	if err := cmaListAll(client, path, nil, &entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !isTestAccObject(entry.Sys.ID) && !isTestAccObject(entry.Sys.ContentType.Sys.ID) {
+			continue
+		}
+
+		if entry.Sys.PublishedAt != "" {
+			if err := client.Entries.Unpublish(env, entry); err != nil {
+				return err
+			}
+
+			entry.Sys.Version++
+		}
+
+		if entry.Sys.ArchivedAt != "" {
+			if err := client.Entries.Unarchive(env, entry); err != nil {
+				return err
+			}
+		}
+
+		if err := client.Entries.Delete(env, entry.Sys.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccContentfulEntry_Basic(t *testing.T) {
 	var entry contentful.Entry
 
@@ -39,6 +93,370 @@ func TestAccContentfulEntry_Basic(t *testing.T) {
 	})
 }
 
+func TestContentfulEntry_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_entry", "/spaces/%s/environments/master/entries/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulEntryConfig("Hello, World!"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "id", "tf-acc-test-entry"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "contenttype_id", "mycontenttype"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "version", "2"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "published_version", "1"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "status", "published"),
+					resource.TestCheckResourceAttrSet("contentful_entry.myentry", "first_published_at"),
+				),
+			},
+			{
+				Config: testFakeContentfulEntryConfig("Hello, Terraform!"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "version", "4"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "published_version", "3"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "status", "published"),
+				),
+			},
+			{
+				// A draft change made in the web app is published again.
+				PreConfig: func() {
+					path := fmt.Sprintf("/spaces/%s/environments/master/entries/tf-acc-test-entry", fakeSpaceID)
+					entry := fake.get(path)
+					fakeSys(entry)["version"] = fakeVersion(entry) + 1
+					fake.put(path, entry)
+				},
+				Config: testFakeContentfulEntryConfig("Hello, Terraform!"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "published_version", "5"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "status", "published"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulEntry_EntryID(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_entry", "/spaces/%s/environments/master/entries/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulEntryIDConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("contentful_entry.myentry", "id"),
+					resource.TestCheckResourceAttrPair("contentful_entry.myentry", "entry_id", "contentful_entry.myentry", "id"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "status", "draft"),
+				),
+			},
+			{
+				Config:   testFakeContentfulEntryIDConfig(""),
+				PlanOnly: true,
+			},
+			{
+				Config: testFakeContentfulEntryIDConfig("tf-acc-test-entry"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "id", "tf-acc-test-entry"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulEntry_Validation(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_entry", "/spaces/%s/environments/master/entries/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulEntryFieldConfig("field1", "en-US"),
+			},
+			{
+				Config:      testFakeContentfulEntryFieldConfig("field2", "en-US"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("field field2 does not exist"),
+			},
+			{
+				Config:      testFakeContentfulEntryFieldConfig("field1", "de-DE"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("locale de-DE of field field1 does not exist"),
+			},
+		},
+	})
+}
+
+func TestContentfulEntry_UnmanagedPublishState(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_entry", "/spaces/%s/environments/master/entries/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulEntryUnmanagedConfig(""),
+				Check:  resource.TestCheckResourceAttr("contentful_entry.myentry", "status", "draft"),
+			},
+			{
+				// The required field is only checked for entries this
+				// resource publishes.
+				Config:   testFakeContentfulEntryUnmanagedConfig(""),
+				PlanOnly: true,
+			},
+			{
+				Config:             testFakeContentfulEntryUnmanagedConfig("published = true\n  archived = true"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestContentfulEntry_TerraformOnlyChanges(t *testing.T) {
+	fake := newFakeCMA(t)
+	path := fmt.Sprintf("/spaces/%s/environments/master/entries/tf-acc-test-entry", fakeSpaceID)
+
+	var version int
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_entry", "/spaces/%s/environments/master/entries/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulEntryUnmanagedConfig(""),
+				Check: func(s *terraform.State) error {
+					version = fakeVersion(fake.get(path))
+					return nil
+				},
+			},
+			{
+				// Attributes that are not sent to Contentful do not update
+				// the entry, so its version stays the same.
+				Config: testFakeContentfulEntryUnmanagedConfig("force_overwrite = true\n  prevent_delete_if_referenced = true\n  published = true"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						if current := fakeVersion(fake.get(path)); current != version {
+							return fmt.Errorf("expected the entry to stay at version %v, got %v", version, current)
+						}
+
+						return nil
+					},
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "force_overwrite", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulEntry_FieldAndLocaleCreatedInSameApply(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_entry", "/spaces/%s/environments/master/entries/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulEntryFieldConfig("field1", "en-US"),
+			},
+			{
+				Config: testFakeContentfulEntryNewFieldAndLocaleConfig(),
+				Check: func(s *terraform.State) error {
+					entry := fake.get(fmt.Sprintf("/spaces/%s/environments/master/entries/tf-acc-test-entry", fakeSpaceID))
+					fields, _ := entry["fields"].(map[string]interface{})
+					value, _ := fields["field2"].(map[string]interface{})
+
+					if value["de-DE"] != "Hallo, Welt!" {
+						return fmt.Errorf("expected field2 of the entry to have a de-DE value, got %v", fields)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestContentfulEntry_Tags(t *testing.T) {
+	fake := newFakeCMA(t)
+	path := fmt.Sprintf("/spaces/%s/environments/master/entries/tf-acc-test-entry", fakeSpaceID)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_entry", "/spaces/%s/environments/master/entries/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulEntryTagsConfig(`["tf-acc-test-brand"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "tags.#", "1"),
+					testFakeCheckEntityTags(fake, path, "tf-acc-test-brand"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "status", "published"),
+				),
+			},
+			{
+				// Tags removed in the web app are added again.
+				PreConfig: func() {
+					entry := fake.get(path)
+					delete(entry, "metadata")
+					fake.put(path, entry)
+				},
+				Config:             testFakeContentfulEntryTagsConfig(`["tf-acc-test-brand"]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testFakeContentfulEntryTagsConfig(`["tf-acc-test-brand"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeCheckEntityTags(fake, path, "tf-acc-test-brand"),
+				),
+			},
+			{
+				Config: testFakeContentfulEntryTagsConfig(`[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "tags.#", "0"),
+					testFakeCheckEntityTags(fake, path),
+				),
+			},
+		},
+	})
+}
+
+func testFakeCheckEntityTags(fake *fakeCMA, path string, tags ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		current := metadataLinkIDs(fake.get(path), "tags")
+
+		if len(tags) == 0 {
+			tags = []string{}
+		}
+
+		if !equalStrings(current, tags) {
+			return fmt.Errorf("tags of %s are %v, want %v", path, current, tags)
+		}
+
+		return nil
+	}
+}
+
+func TestContentfulEntry_DeleteReferenced(t *testing.T) {
+	fake := newFakeCMA(t)
+	entriesPath := fmt.Sprintf("/spaces/%s/environments/master/entries/", fakeSpaceID)
+
+	fake.put(entriesPath+"author", map[string]interface{}{
+		"sys": map[string]interface{}{
+			"id":              "author",
+			"type":            "Entry",
+			"version":         3,
+			"archivedVersion": 2,
+			"archivedAt":      "2020-01-01T00:00:00Z",
+		},
+	})
+	fake.put(entriesPath+"post", map[string]interface{}{
+		"sys": map[string]interface{}{"id": "post", "type": "Entry", "version": 1},
+		"fields": map[string]interface{}{
+			"author": map[string]interface{}{"en-US": fakeLink("Entry", "author")},
+		},
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceContentfulEntry().Schema, map[string]interface{}{
+		"space_id":                     fakeSpaceID,
+		"env_id":                       "master",
+		"prevent_delete_if_referenced": true,
+	})
+	d.SetId("author")
+
+	err := resourceDeleteEntry(d, fake.client())
+	if err == nil || !strings.Contains(err.Error(), "referenced by 1 other entries (post)") {
+		t.Fatalf("expected the referenced entry to be kept, got %v", err)
+	}
+
+	fake.put(entriesPath+"post", map[string]interface{}{
+		"sys": map[string]interface{}{"id": "post", "type": "Entry", "version": 2},
+	})
+
+	if err = resourceDeleteEntry(d, fake.client()); err != nil {
+		t.Fatalf("deleting the archived entry: %s", err)
+	}
+
+	if fake.get(entriesPath+"author") != nil {
+		t.Fatalf("the entry was not deleted")
+	}
+
+	// Deleting an entry that is already gone succeeds.
+	if err = resourceDeleteEntry(d, fake.client()); err != nil {
+		t.Fatalf("deleting a missing entry: %s", err)
+	}
+}
+
+func TestContentfulEntry_VersionConflict(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_entry", "/spaces/%s/environments/master/entries/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulEntryConfig("Hello, World!"),
+				Check:  testFakeCheckEntryVersionConflict(fake, "contentful_entry.myentry"),
+			},
+		},
+	})
+}
+
+// testFakeCheckEntryVersionConflict changes the entry behind Terraform's back
+// and checks that an update from the stale state is refused, unless
+// force_overwrite is set.
+func testFakeCheckEntryVersionConflict(fake *fakeCMA, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not Found: %s", n)
+		}
+
+		path := fmt.Sprintf("/spaces/%s/environments/master/entries/%s", fakeSpaceID, rs.Primary.ID)
+		remote := fake.get(path)
+		remote["fields"] = map[string]interface{}{
+			"field1": map[string]interface{}{"en-US": "Changed in the web app"},
+		}
+		fakeSys(remote)["version"] = fakeVersion(remote) + 1
+		fake.put(path, remote)
+
+		d, err := schema.InternalMap(resourceContentfulEntry().Schema).Data(rs.Primary, &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"field.0.content": {Old: rs.Primary.Attributes["field.0.content"], New: "Hello, Terraform!"},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = resourceUpdateEntry(d, fake.client())
+		if err == nil {
+			return fmt.Errorf("expected a version conflict")
+		}
+
+		if !strings.Contains(err.Error(), "remotely changed fields: field1") {
+			return fmt.Errorf("unexpected error: %s", err)
+		}
+
+		if err = d.Set("force_overwrite", true); err != nil {
+			return err
+		}
+
+		if err = resourceUpdateEntry(d, fake.client()); err != nil {
+			return err
+		}
+
+		if content := fake.get(path)["fields"].(map[string]interface{})["field1"].(map[string]interface{})["en-US"]; content != "Hello, Terraform!" {
+			return fmt.Errorf("field1 was not overwritten: %v", content)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckContentfulEntryExists(n string, entry *contentful.Entry) resource.TestCheckFunc {
 	env := &contentful.Environment{
 		Sys: &contentful.Sys{
@@ -137,7 +555,7 @@ var testAccContentfulEntryConfig = `
 resource "contentful_contenttype" "mycontenttype" {
   space_id = "` + spaceID + `"
 	env_id = "` + envID + `"
-  name = "tf_test_1"
+  name = "tf-acc-test-1"
   description = "Terraform Acc Test Content Type"
   display_field = "field1"
   field {
@@ -161,7 +579,7 @@ resource "contentful_contenttype" "mycontenttype" {
 }
 
 resource "contentful_entry" "myentry" {
-  entry_id = "mytestentry"
+  entry_id = "tf-acc-test-entry"
   space_id = "` + spaceID + `"
 	env_id = "` + envID + `"
   contenttype_id = "${contentful_contenttype.mycontenttype.id}"
@@ -186,7 +604,7 @@ var testAccContentfulEntryUpdateConfig = `
 resource "contentful_contenttype" "mycontenttype" {
   space_id = "` + spaceID + `"
 	env_id = "` + envID + `"
-  name = "tf_test_1"
+  name = "tf-acc-test-1"
   description = "Terraform Acc Test Content Type"
   display_field = "field1"
   field {
@@ -210,7 +628,7 @@ resource "contentful_contenttype" "mycontenttype" {
 }
 
 resource "contentful_entry" "myentry" {
-  entry_id = "mytestentry"
+  entry_id = "tf-acc-test-entry"
   space_id = "` + spaceID + `"
 	env_id = "` + envID + `"
   contenttype_id = "${contentful_contenttype.mycontenttype.id}"
@@ -230,3 +648,238 @@ resource "contentful_entry" "myentry" {
   depends_on = [contentful_contenttype.mycontenttype]
 }
 `
+
+func testFakeContentfulEntryConfig(content string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    required  = true
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id = "tf-acc-test-entry"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "%[2]s"
+    locale = "en-US"
+  }
+  published = true
+  archived  = false
+}
+`, fakeSpaceID, content)
+}
+
+func testFakeContentfulEntryIDConfig(entryID string) string {
+	if entryID != "" {
+		entryID = fmt.Sprintf("entry_id = %q", entryID)
+	}
+
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  %[2]s
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "Hello, World!"
+    locale = "en-US"
+  }
+  published = false
+  archived  = false
+}
+`, fakeSpaceID, entryID)
+}
+
+func testFakeContentfulEntryFieldConfig(fieldID, locale string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    required  = true
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id = "tf-acc-test-entry"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "%[2]s"
+    content = "Hello, World!"
+    locale = "%[3]s"
+  }
+  published = true
+  archived  = false
+}
+`, fakeSpaceID, fieldID, locale)
+}
+
+func testFakeContentfulEntryNewFieldAndLocaleConfig() string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    required  = true
+    type      = "Text"
+  }
+  field {
+    id        = "field2"
+    name      = "Field 2"
+    localized = true
+    type      = "Text"
+  }
+}
+
+resource "contentful_locale" "de" {
+  space_id = "%[1]s"
+  name = "German"
+  code = "de-DE"
+  deletion_protection = false
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id = "tf-acc-test-entry"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "Hello, World!"
+    locale = "en-US"
+  }
+  field {
+    id = "field2"
+    content = "Hallo, Welt!"
+    locale = "de-DE"
+  }
+  published = true
+  archived  = false
+
+  depends_on = [contentful_locale.de]
+}
+`, fakeSpaceID)
+}
+
+func testFakeContentfulEntryUnmanagedConfig(publishState string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    required  = true
+    type      = "Text"
+  }
+  field {
+    id        = "field2"
+    name      = "Field 2"
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id = "tf-acc-test-entry"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field2"
+    content = "Hello, World!"
+    locale = "en-US"
+  }
+  manage_publish_state = false
+  %[2]s
+}
+`, fakeSpaceID, publishState)
+}
+
+func testFakeContentfulEntryTagsConfig(tags string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }
+}
+
+resource "contentful_tag" "brand" {
+  space_id = "%[1]s"
+  env_id = "master"
+  tag_id = "tf-acc-test-brand"
+  name = "Brand"
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id = "tf-acc-test-entry"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "Hello, World!"
+    locale = "en-US"
+  }
+  tags = %[2]s
+  published = true
+  archived  = false
+
+  depends_on = [contentful_tag.brand]
+}
+`, fakeSpaceID, tags)
+}
@@ -1,6 +1,9 @@
 package contentful
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	contentful "github.com/regressivetech/contentful-go"
 )
@@ -12,6 +15,12 @@ func resourceContentfulEnvironment() *schema.Resource {
 		Update: resourceUpdateEnvironment,
 		Delete: resourceDeleteEnvironment,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:     schema.TypeInt,
@@ -25,6 +34,11 @@ func resourceContentfulEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -41,6 +55,13 @@ func resourceCreateEnvironment(d *schema.ResourceData, m interface{}) (err error
 		return err
 	}
 
+	// New environments are cloned from master in the background and cannot
+	// be used until they are ready.
+	err = waitForEnvironment(client, d.Get("space_id").(string), environment.Sys.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
 	if err := setEnvironmentProperties(d, environment); err != nil {
 		return err
 	}
@@ -95,12 +116,18 @@ func resourceDeleteEnvironment(d *schema.ResourceData, m interface{}) (err error
 	spaceID := d.Get("space_id").(string)
 	environmentID := d.Id()
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("environment %s has deletion_protection enabled, set deletion_protection = false and apply before destroying it", environmentID)
+	}
+
 	environment, err := client.Environments.Get(spaceID, environmentID)
 	if err != nil {
 		return err
 	}
 
-	return client.Environments.Delete(spaceID, environment)
+	return retryRateLimited(d.Timeout(schema.TimeoutDelete), func() error {
+		return client.Environments.Delete(spaceID, environment)
+	})
 }
 
 func setEnvironmentProperties(d *schema.ResourceData, environment *contentful.Environment) error {
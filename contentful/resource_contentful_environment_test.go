@@ -9,6 +9,37 @@ import (
 	contentful "github.com/regressivetech/contentful-go"
 )
 
+func init() {
+	resource.AddTestSweepers("contentful_environment", &resource.Sweeper{
+		Name: "contentful_environment",
+		F:    testSweepEnvironments,
+	})
+}
+
+func testSweepEnvironments(region string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+
+	var environments []*contentful.Environment
+	if err := cmaListAll(client, fmt.Sprintf("/spaces/%s/environments", spaceID), nil, &environments); err != nil {
+		return err
+	}
+
+	for _, environment := range environments {
+		if !isTestAccObject(environment.Sys.ID) {
+			continue
+		}
+
+		if err := client.Environments.Delete(spaceID, environment); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccContentfulEnvironment_Basic(t *testing.T) {
 	var environment contentful.Environment
 
@@ -23,7 +54,7 @@ func TestAccContentfulEnvironment_Basic(t *testing.T) {
 					testAccCheckContentfulEnvironmentExists("contentful_environment.myenvironment", &environment),
 					testAccCheckContentfulEnvironmentAttributes(&environment, map[string]interface{}{
 						"space_id": spaceID,
-						"name":     "provider-test",
+						"name":     "tf-acc-test-environment",
 					}),
 				),
 			},
@@ -33,7 +64,7 @@ func TestAccContentfulEnvironment_Basic(t *testing.T) {
 					testAccCheckContentfulEnvironmentExists("contentful_environment.myenvironment", &environment),
 					testAccCheckContentfulEnvironmentAttributes(&environment, map[string]interface{}{
 						"space_id": spaceID,
-						"name":     "provider-test-updated",
+						"name":     "tf-acc-test-environment-updated",
 					}),
 				),
 			},
@@ -41,6 +72,31 @@ func TestAccContentfulEnvironment_Basic(t *testing.T) {
 	})
 }
 
+func TestContentfulEnvironment_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_environment", "/spaces/%s/environments/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulEnvironmentConfig("tf-acc-test-environment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_environment.myenvironment", "id", "tf-acc-test-environment"),
+					resource.TestCheckResourceAttr("contentful_environment.myenvironment", "name", "tf-acc-test-environment"),
+				),
+			},
+			{
+				Config: testFakeContentfulEnvironmentConfig("tf-acc-test-environment-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_environment.myenvironment", "name", "tf-acc-test-environment-updated"),
+					resource.TestCheckResourceAttr("contentful_environment.myenvironment", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContentfulEnvironmentExists(n string, environment *contentful.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -113,13 +169,29 @@ func testAccContentfulEnvironmentDestroy(s *terraform.State) error {
 var testAccContentfulEnvironmentConfig = `
 resource "contentful_environment" "myenvironment" {
   space_id = "` + spaceID + `"
-  name = "provider-test"
+  name = "tf-acc-test-environment"
+  deletion_protection = false
 }
 `
 
 var testAccContentfulEnvironmentUpdateConfig = `
 resource "contentful_environment" "myenvironment" {
   space_id = "` + spaceID + `"
-  name = "provider-test-updated"
+  name = "tf-acc-test-environment-updated"
+  deletion_protection = false
 }
 `
+
+func testFakeContentfulEnvironmentConfig(name string) string {
+	return fmt.Sprintf(`
+resource "contentful_environment" "myenvironment" {
+  space_id = "%s"
+  name = "%s"
+  deletion_protection = false
+
+  timeouts {
+    create = "1m"
+  }
+}
+`, fakeSpaceID, name)
+}
@@ -1,6 +1,10 @@
 package contentful
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	contentful "github.com/regressivetech/contentful-go"
 )
@@ -12,6 +16,14 @@ func resourceContentfulLocale() *schema.Resource {
 		Update: resourceUpdateLocale,
 		Delete: resourceDeleteLocale,
 
+		CustomizeDiff: resourceLocaleCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:     schema.TypeInt,
@@ -30,9 +42,15 @@ func resourceContentfulLocale() *schema.Resource {
 				Required: true,
 			},
 			"fallback_code": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "en-US",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The code of the locale to fall back to. It must exist in the space or be created by a contentful_locale that this one references or depends on.",
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the locale is the default locale of the space. Do not use it together with `default_locale` on the contentful_space, which manages the same setting.",
 			},
 			"optional": {
 				Type:     schema.TypeBool,
@@ -49,6 +67,11 @@ func resourceContentfulLocale() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -61,12 +84,19 @@ func resourceCreateLocale(d *schema.ResourceData, m interface{}) (err error) {
 		Name:         d.Get("name").(string),
 		Code:         d.Get("code").(string),
 		FallbackCode: d.Get("fallback_code").(string),
+		Default:      d.Get("default").(bool),
 		Optional:     d.Get("optional").(bool),
 		CDA:          d.Get("cda").(bool),
 		CMA:          d.Get("cma").(bool),
 	}
 
-	err = client.Locales.Upsert(spaceID, locale)
+	if err = checkLocaleFallbackExists(client, spaceID, locale); err != nil {
+		return err
+	}
+
+	err = retryRateLimited(d.Timeout(schema.TimeoutCreate), func() error {
+		return client.Locales.Upsert(spaceID, locale)
+	})
 	if err != nil {
 		return err
 	}
@@ -87,7 +117,7 @@ func resourceReadLocale(d *schema.ResourceData, m interface{}) error {
 	localeID := d.Id()
 
 	locale, err := client.Locales.Get(spaceID, localeID)
-	if _, ok := err.(*contentful.NotFoundError); ok {
+	if _, ok := err.(contentful.NotFoundError); ok {
 		d.SetId("")
 		return nil
 	}
@@ -109,14 +139,27 @@ func resourceUpdateLocale(d *schema.ResourceData, m interface{}) (err error) {
 		return err
 	}
 
+	// The default locale can only be moved by making another locale the
+	// default, Contentful refuses to unset the flag directly.
+	if locale.Default && !d.Get("default").(bool) {
+		return fmt.Errorf("locale %s is the default locale of space %s, set default = true on another locale to replace it", locale.Code, spaceID)
+	}
+
 	locale.Name = d.Get("name").(string)
 	locale.Code = d.Get("code").(string)
 	locale.FallbackCode = d.Get("fallback_code").(string)
 	locale.Optional = d.Get("optional").(bool)
 	locale.CDA = d.Get("cda").(bool)
 	locale.CMA = d.Get("cma").(bool)
+	locale.Default = d.Get("default").(bool)
 
-	err = client.Locales.Upsert(spaceID, locale)
+	if err = checkLocaleFallbackExists(client, spaceID, locale); err != nil {
+		return err
+	}
+
+	err = retryRateLimited(d.Timeout(schema.TimeoutUpdate), func() error {
+		return client.Locales.Upsert(spaceID, locale)
+	})
 	if err != nil {
 		return err
 	}
@@ -134,13 +177,28 @@ func resourceDeleteLocale(d *schema.ResourceData, m interface{}) (err error) {
 	spaceID := d.Get("space_id").(string)
 	localeID := d.Id()
 
+	// Deleting a locale drops every translation stored for it.
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("locale %s has deletion_protection enabled, set deletion_protection = false and apply before destroying it", d.Get("code").(string))
+	}
+
 	locale, err := client.Locales.Get(spaceID, localeID)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+
 	if err != nil {
 		return err
 	}
 
-	err = client.Locales.Delete(spaceID, locale)
-	if _, ok := err.(*contentful.NotFoundError); ok {
+	if locale.Default {
+		return fmt.Errorf("locale %s is the default locale of space %s and cannot be deleted", locale.Code, spaceID)
+	}
+
+	err = retryRateLimited(d.Timeout(schema.TimeoutDelete), func() error {
+		return client.Locales.Delete(spaceID, locale)
+	})
+	if _, ok := err.(contentful.NotFoundError); ok {
 		return nil
 	}
 
@@ -152,7 +210,12 @@ func resourceDeleteLocale(d *schema.ResourceData, m interface{}) (err error) {
 }
 
 func setLocaleProperties(d *schema.ResourceData, locale *contentful.Locale) error {
-	err := d.Set("name", locale.Name)
+	err := d.Set("version", locale.Sys.Version)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", locale.Name)
 	if err != nil {
 		return err
 	}
@@ -167,6 +230,11 @@ func setLocaleProperties(d *schema.ResourceData, locale *contentful.Locale) erro
 		return err
 	}
 
+	err = d.Set("default", locale.Default)
+	if err != nil {
+		return err
+	}
+
 	err = d.Set("optional", locale.Optional)
 	if err != nil {
 		return err
@@ -184,3 +252,104 @@ func setLocaleProperties(d *schema.ResourceData, locale *contentful.Locale) erro
 
 	return nil
 }
+
+// resourceLocaleCustomizeDiff validates the fallback chain of the planned
+// locale against the locales that already exist in the space. A fallback_code
+// that does not match any of them is only accepted when a locale with that
+// code is planned to be created in the same apply.
+func resourceLocaleCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("space_id") || !d.NewValueKnown("code") || !d.NewValueKnown("fallback_code") {
+		return nil
+	}
+
+	client := m.(*contentful.Client)
+	spaceID := d.Get("space_id").(string)
+	code := d.Get("code").(string)
+	fallbackCode := d.Get("fallback_code").(string)
+
+	if d.Id() == "" || d.HasChange("code") {
+		planChange(client, localeCodePath(spaceID, code))
+	}
+
+	if d.Get("default").(bool) && fallbackCode != "" {
+		return fmt.Errorf("locale %s is the default locale and cannot have a fallback_code", code)
+	}
+
+	if fallbackCode == "" {
+		return nil
+	}
+
+	collection, err := client.Locales.List(spaceID).Next()
+	if err != nil {
+		return err
+	}
+
+	locales := collection.ToLocale()
+	if !hasLocaleCode(locales, fallbackCode, code) && !isChangePlanned(client, localeCodePath(spaceID, fallbackCode)) {
+		return fmt.Errorf("fallback_code %s of locale %s does not match any locale in the space", fallbackCode, code)
+	}
+
+	return validateLocaleFallback(locales, d.Id(), code, fallbackCode)
+}
+
+// hasLocaleCode reports whether a locale other than the one with the code
+// self has the given code.
+func hasLocaleCode(locales []*contentful.Locale, code, self string) bool {
+	for _, locale := range locales {
+		if locale.Code == code && locale.Code != self {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateLocaleFallback checks that following the fallback chain from code
+// never leads back to code. The locale identified by localeID is replaced by
+// the planned values.
+func validateLocaleFallback(locales []*contentful.Locale, localeID, code, fallbackCode string) error {
+	fallbacks := map[string]string{}
+	for _, locale := range locales {
+		if localeID != "" && locale.Sys != nil && locale.Sys.ID == localeID {
+			continue
+		}
+
+		fallbacks[locale.Code] = locale.FallbackCode
+	}
+
+	fallbacks[code] = fallbackCode
+
+	chain := []string{code}
+	visited := map[string]bool{code: true}
+	for next := fallbackCode; next != ""; next = fallbacks[next] {
+		chain = append(chain, next)
+		if visited[next] {
+			return fmt.Errorf("fallback_code of locale %s creates a cycle: %s", code, strings.Join(chain, " -> "))
+		}
+
+		visited[next] = true
+	}
+
+	return nil
+}
+
+// checkLocaleFallbackExists returns an error when the fallback_code of the
+// locale does not match any other locale in the space. It runs right before
+// the locale is written, to catch fallbacks that were accepted at plan time
+// because a locale with that code was planned, but failed to be created.
+func checkLocaleFallbackExists(client *contentful.Client, spaceID string, locale *contentful.Locale) error {
+	if locale.FallbackCode == "" {
+		return nil
+	}
+
+	collection, err := client.Locales.List(spaceID).Next()
+	if err != nil {
+		return err
+	}
+
+	if hasLocaleCode(collection.ToLocale(), locale.FallbackCode, locale.Code) {
+		return nil
+	}
+
+	return fmt.Errorf("fallback_code %s of locale %s does not match any locale in the space", locale.FallbackCode, locale.Code)
+}
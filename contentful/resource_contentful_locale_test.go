@@ -2,6 +2,7 @@ package contentful
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -9,6 +10,38 @@ import (
 	contentful "github.com/regressivetech/contentful-go"
 )
 
+func init() {
+	resource.AddTestSweepers("contentful_locale", &resource.Sweeper{
+		Name:         "contentful_locale",
+		F:            testSweepLocales,
+		Dependencies: []string{"contentful_entry", "contentful_asset"},
+	})
+}
+
+func testSweepLocales(region string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+
+	var locales []*contentful.Locale
+	if err := cmaListAll(client, fmt.Sprintf("/spaces/%s/locales", spaceID), nil, &locales); err != nil {
+		return err
+	}
+
+	for _, locale := range locales {
+		if !isTestAccObject(locale.Name) || locale.Default {
+			continue
+		}
+
+		if err := client.Locales.Delete(spaceID, locale); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccContentfulLocales_Basic(t *testing.T) {
 	var locale contentful.Locale
 
@@ -21,9 +54,10 @@ func TestAccContentfulLocales_Basic(t *testing.T) {
 				Config: testAccContentfulLocaleConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckContentfulLocaleExists("contentful_locale.mylocale", &locale),
+					resource.TestCheckResourceAttr("contentful_locale.mylocale", "default", "false"),
 					testAccCheckContentfulLocaleAttributes(&locale, map[string]interface{}{
 						"space_id":      spaceID,
-						"name":          "locale-name",
+						"name":          "tf-acc-test-locale",
 						"code":          "de",
 						"fallback_code": "en-US",
 						"optional":      false,
@@ -38,7 +72,7 @@ func TestAccContentfulLocales_Basic(t *testing.T) {
 					testAccCheckContentfulLocaleExists("contentful_locale.mylocale", &locale),
 					testAccCheckContentfulLocaleAttributes(&locale, map[string]interface{}{
 						"space_id":      spaceID,
-						"name":          "locale-name-updated",
+						"name":          "tf-acc-test-locale-updated",
 						"code":          "es",
 						"fallback_code": "en-US",
 						"optional":      true,
@@ -51,6 +85,108 @@ func TestAccContentfulLocales_Basic(t *testing.T) {
 	})
 }
 
+func TestContentfulLocale_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_locale", "/spaces/%s/locales/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulLocaleConfig("tf-acc-test-locale", "de", "en-US"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_locale.mylocale", "code", "de"),
+					resource.TestCheckResourceAttr("contentful_locale.mylocale", "fallback_code", "en-US"),
+					resource.TestCheckResourceAttr("contentful_locale.mylocale", "version", "1"),
+				),
+			},
+			{
+				Config: testFakeContentfulLocaleConfig("tf-acc-test-locale-updated", "es", "en-US"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_locale.mylocale", "name", "tf-acc-test-locale-updated"),
+					resource.TestCheckResourceAttr("contentful_locale.mylocale", "code", "es"),
+					resource.TestCheckResourceAttr("contentful_locale.mylocale", "version", "2"),
+				),
+			},
+			{
+				Config:      testFakeContentfulLocaleConfig("tf-acc-test-locale-updated", "es", "fr"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("fallback_code fr of locale es does not match any locale in the space"),
+			},
+		},
+	})
+}
+
+func TestContentfulLocale_FallbackCreatedInSameApply(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_locale", "/spaces/%s/locales/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "contentful_locale" "de" {
+  space_id = "%[1]s"
+
+  name = "tf-acc-test-de"
+  code = "de"
+  fallback_code = "en-US"
+  deletion_protection = false
+}
+
+resource "contentful_locale" "de_ch" {
+  space_id = "%[1]s"
+
+  name = "tf-acc-test-de-ch"
+  code = "de-CH"
+  fallback_code = "de"
+  deletion_protection = false
+
+  depends_on = [contentful_locale.de]
+}
+`, fakeSpaceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_locale.de_ch", "fallback_code", "de"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateLocaleFallback(t *testing.T) {
+	locales := []*contentful.Locale{
+		{Sys: &contentful.Sys{ID: "1"}, Code: "en-US"},
+		{Sys: &contentful.Sys{ID: "2"}, Code: "de", FallbackCode: "en-US"},
+		{Sys: &contentful.Sys{ID: "3"}, Code: "de-CH", FallbackCode: "de"},
+	}
+
+	cases := []struct {
+		name         string
+		localeID     string
+		code         string
+		fallbackCode string
+		expectError  bool
+	}{
+		{"new locale with known fallback", "", "nl", "en-US", false},
+		{"new locale with a fallback created in the same apply", "", "nl", "fr", false},
+		{"locale falling back to itself", "", "nl", "nl", true},
+		{"existing locale closing a cycle", "1", "en-US", "de-CH", true},
+		{"existing locale moving its fallback", "3", "de-CH", "en-US", false},
+	}
+
+	for _, c := range cases {
+		err := validateLocaleFallback(locales, c.localeID, c.code, c.fallbackCode)
+		if c.expectError && err == nil {
+			t.Errorf("%s: expected an error", c.name)
+		}
+
+		if !c.expectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+		}
+	}
+}
+
 func testAccCheckContentfulLocaleExists(n string, locale *contentful.Locale) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -149,12 +285,13 @@ var testAccContentfulLocaleConfig = `
 resource "contentful_locale" "mylocale" {
   space_id = "` + spaceID + `"
 
-  name = "locale-name"
+  name = "tf-acc-test-locale"
   code = "de"
   fallback_code = "en-US"
   optional = false
   cda = false
   cma = true
+  deletion_protection = false
 }
 `
 
@@ -162,11 +299,25 @@ var testAccContentfulLocaleUpdateConfig = `
 resource "contentful_locale" "mylocale" {
   space_id = "` + spaceID + `"
 
-  name = "locale-name-updated"
+  name = "tf-acc-test-locale-updated"
   code = "es"
   fallback_code = "en-US"
   optional = true
   cda = true
   cma = false
+  deletion_protection = false
 }
 `
+
+func testFakeContentfulLocaleConfig(name, code, fallbackCode string) string {
+	return fmt.Sprintf(`
+resource "contentful_locale" "mylocale" {
+  space_id = "%s"
+
+  name = "%s"
+  code = "%s"
+  fallback_code = "%s"
+  deletion_protection = false
+}
+`, fakeSpaceID, name, code, fallbackCode)
+}
@@ -0,0 +1,194 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// release groups entries and assets of an environment so they can be
+// published or unpublished together.
+type release struct {
+	Sys      *contentful.Sys  `json:"sys,omitempty"`
+	Title    string           `json:"title"`
+	Entities *releaseEntities `json:"entities"`
+}
+
+type releaseEntities struct {
+	Sys   *contentful.Sys `json:"sys"`
+	Items []*link         `json:"items"`
+}
+
+func resourceContentfulRelease() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateRelease,
+		Read:   resourceReadRelease,
+		Update: resourceUpdateRelease,
+		Delete: resourceDeleteRelease,
+
+		CustomizeDiff: resourceReleaseCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"entries": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"assets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func releasePath(spaceID, envID, releaseID string) string {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases", spaceID, envID)
+	if releaseID != "" {
+		path += "/" + releaseID
+	}
+
+	return path
+}
+
+func expandRelease(d *schema.ResourceData) *release {
+	items := expandLinks("Entry", d.Get("entries").(*schema.Set))
+	items = append(items, expandLinks("Asset", d.Get("assets").(*schema.Set))...)
+
+	return &release{
+		Title: d.Get("title").(string),
+		Entities: &releaseEntities{
+			Sys:   &contentful.Sys{Type: "Array"},
+			Items: items,
+		},
+	}
+}
+
+// resourceReleaseCustomizeDiff marks the version as changing with the
+// release, so actions triggered by it run again.
+func resourceReleaseCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && (d.HasChange("title") || d.HasChange("entries") || d.HasChange("assets")) {
+		return d.SetNewComputed("version")
+	}
+
+	return nil
+}
+
+func resourceCreateRelease(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	path := releasePath(d.Get("space_id").(string), d.Get("env_id").(string), "")
+
+	var created release
+	if err = cmaRequest(client, http.MethodPost, path, nil, 0, expandRelease(d), &created); err != nil {
+		return err
+	}
+
+	d.SetId(created.Sys.ID)
+
+	return setReleaseProperties(d, &created)
+}
+
+func resourceUpdateRelease(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	path := releasePath(d.Get("space_id").(string), d.Get("env_id").(string), d.Id())
+
+	var current release
+	if err = cmaRequest(client, http.MethodGet, path, nil, 0, nil, &current); err != nil {
+		return err
+	}
+
+	var updated release
+	if err = cmaRequest(client, http.MethodPut, path, nil, current.Sys.Version, expandRelease(d), &updated); err != nil {
+		return err
+	}
+
+	return setReleaseProperties(d, &updated)
+}
+
+func resourceReadRelease(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	path := releasePath(d.Get("space_id").(string), d.Get("env_id").(string), d.Id())
+
+	var current release
+	err = cmaRequest(client, http.MethodGet, path, nil, 0, nil, &current)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setReleaseProperties(d, &current)
+}
+
+func resourceDeleteRelease(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	path := releasePath(d.Get("space_id").(string), d.Get("env_id").(string), d.Id())
+
+	return retryTransient(d.Timeout(schema.TimeoutDelete), func() error {
+		err := cmaRequest(client, http.MethodDelete, path, nil, 0, nil, nil)
+		if _, ok := err.(contentful.NotFoundError); ok {
+			return nil
+		}
+
+		return err
+	})
+}
+
+func setReleaseProperties(d *schema.ResourceData, r *release) error {
+	entries := []string{}
+	assets := []string{}
+	if r.Entities != nil {
+		for _, item := range r.Entities.Items {
+			switch item.Sys.LinkType {
+			case "Entry":
+				entries = append(entries, item.Sys.ID)
+			case "Asset":
+				assets = append(assets, item.Sys.ID)
+			}
+		}
+	}
+
+	values := map[string]interface{}{
+		"version": r.Sys.Version,
+		"title":   r.Title,
+		"entries": entries,
+		"assets":  assets,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+func resourceContentfulReleaseAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateReleaseAction,
+		Read:   resourceReadReleaseAction,
+		Delete: resourceDeleteReleaseAction,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		// An action runs once. Changing any argument runs a new action,
+		// and triggers can be used to run it again when something else
+		// changes, like the version of the release.
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"release_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"publish", "unpublish", "validate"}, false),
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func releaseActionPath(d *schema.ResourceData, actionID string) string {
+	return releasePath(d.Get("space_id").(string), d.Get("env_id").(string), d.Get("release_id").(string)) + "/actions/" + actionID
+}
+
+// resourceCreateReleaseAction starts the action and waits for it. An action
+// that fails is kept in the state as tainted, so the next apply runs it again.
+func resourceCreateReleaseAction(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	path := releasePath(d.Get("space_id").(string), d.Get("env_id").(string), d.Get("release_id").(string))
+
+	var current release
+	if err = cmaRequest(client, http.MethodGet, path, nil, 0, nil, &current); err != nil {
+		return err
+	}
+
+	var started asyncAction
+	switch d.Get("action").(string) {
+	case "publish":
+		err = cmaRequest(client, http.MethodPut, path+"/published", nil, current.Sys.Version, nil, &started)
+	case "unpublish":
+		err = cmaRequest(client, http.MethodDelete, path+"/published", nil, current.Sys.Version, nil, &started)
+	case "validate":
+		err = cmaRequest(client, http.MethodPost, path+"/validate", nil, 0, map[string]string{"action": "publish"}, &started)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(started.Sys.ID)
+
+	action, err := waitForAsyncAction(client, releaseActionPath(d, started.Sys.ID), d.Timeout(schema.TimeoutCreate))
+	if action != nil {
+		if setErr := d.Set("status", action.Sys.Status); setErr != nil {
+			return setErr
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("release %s: %s", d.Get("release_id").(string), err)
+	}
+
+	return nil
+}
+
+func resourceReadReleaseAction(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	var action asyncAction
+	err = cmaRequest(client, http.MethodGet, releaseActionPath(d, d.Id()), nil, 0, nil, &action)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return d.Set("status", action.Sys.Status)
+}
+
+// resourceDeleteReleaseAction only removes the action from the state, since
+// an action that has run cannot be undone.
+func resourceDeleteReleaseAction(d *schema.ResourceData, m interface{}) (err error) {
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package contentful
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccContentfulRelease_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulReleaseConfig("tf-acc-test-release"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_release.myrelease", "entries.#", "1"),
+					resource.TestCheckResourceAttr("contentful_release_action.validate", "status", "succeeded"),
+				),
+			},
+			{
+				Config: testAccContentfulReleaseConfig("tf-acc-test-release-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_release.myrelease", "title", "tf-acc-test-release-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulRelease_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+	entriesPath := fmt.Sprintf("/spaces/%s/environments/master/entries/", fakeSpaceID)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_release", "/spaces/%s/environments/master/releases/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulReleaseConfig("Launch", "", "validate"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_release.myrelease", "title", "Launch"),
+					resource.TestCheckResourceAttr("contentful_release.myrelease", "entries.#", "2"),
+					resource.TestCheckResourceAttr("contentful_release_action.myaction", "status", "succeeded"),
+				),
+			},
+			{
+				// The entries are published by the release, so the entry
+				// resources plan to unpublish them again.
+				Config: testFakeContentfulReleaseConfig("Launch day", "", "publish"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_release.myrelease", "title", "Launch day"),
+					resource.TestCheckResourceAttr("contentful_release.myrelease", "version", "2"),
+					resource.TestCheckResourceAttr("contentful_release_action.myaction", "status", "succeeded"),
+					testFakeCheckPublished(fake, entriesPath+"tf-acc-test-entry-1"),
+					testFakeCheckPublished(fake, entriesPath+"tf-acc-test-entry-2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testFakeContentfulReleaseConfig("Launch day", `"tf-acc-test-missing"`, "publish"),
+				ExpectError: regexp.MustCompile(`asset tf-acc-test-missing: NotFound`),
+			},
+		},
+	})
+}
+
+func testFakeCheckPublished(fake *fakeCMA, path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		object := fake.get(path)
+		if object == nil {
+			return fmt.Errorf("%s does not exist", path)
+		}
+
+		if fakeSys(object)["publishedVersion"] == nil {
+			return fmt.Errorf("%s is not published", path)
+		}
+
+		return nil
+	}
+}
+
+func testAccContentfulReleaseConfig(title string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  name = "tf-acc-test-release"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "Hello, Release!"
+    locale = "en-US"
+  }
+  published = false
+  archived  = false
+}
+
+resource "contentful_release" "myrelease" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  title = "%[3]s"
+  entries = [contentful_entry.myentry.id]
+}
+
+resource "contentful_release_action" "validate" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  release_id = contentful_release.myrelease.id
+  action = "validate"
+}
+`, spaceID, envID, title)
+}
+
+func testFakeContentfulReleaseConfig(title, assets, action string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "first" {
+  entry_id = "tf-acc-test-entry-1"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "First"
+    locale = "en-US"
+  }
+  published = false
+  archived  = false
+}
+
+resource "contentful_entry" "second" {
+  entry_id = "tf-acc-test-entry-2"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "Second"
+    locale = "en-US"
+  }
+  published = false
+  archived  = false
+}
+
+resource "contentful_release" "myrelease" {
+  space_id = "%[1]s"
+  env_id = "master"
+  title = "%[2]s"
+  entries = [contentful_entry.first.id, contentful_entry.second.id]
+  assets = [%[3]s]
+}
+
+resource "contentful_release_action" "myaction" {
+  space_id = "%[1]s"
+  env_id = "master"
+  release_id = contentful_release.myrelease.id
+  action = "%[4]s"
+  triggers = {
+    version = contentful_release.myrelease.version
+  }
+}
+`, fakeSpaceID, title, assets, action)
+}
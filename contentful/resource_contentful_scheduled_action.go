@@ -0,0 +1,245 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// scheduledActionScheduled is the status of a scheduled action that has not
+// run yet.
+const scheduledActionScheduled = "scheduled"
+
+// scheduledAction is a publish or unpublish of an entry or asset at a later
+// time. contentful-go is not used for them, since it takes their environment
+// from the client, which is shared by all resources.
+type scheduledAction struct {
+	Sys          *scheduledActionSys `json:"sys,omitempty"`
+	Entity       *link               `json:"entity"`
+	Environment  *link               `json:"environment"`
+	ScheduledFor *scheduledFor       `json:"scheduledFor"`
+	Action       string              `json:"action"`
+}
+
+type scheduledActionSys struct {
+	ID      string `json:"id"`
+	Version int    `json:"version"`
+	Status  string `json:"status"`
+}
+
+type scheduledFor struct {
+	Datetime string `json:"datetime"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+func resourceContentfulScheduledAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateScheduledAction,
+		Read:   resourceReadScheduledAction,
+		Delete: resourceDeleteScheduledAction,
+
+		CustomizeDiff: resourceScheduledActionCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		// Once an action has run it cannot be changed, so every change
+		// schedules a new action instead.
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"entry_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"asset_id"},
+			},
+			"asset_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"entry_id"},
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"publish", "unpublish"}, false),
+			},
+			"datetime": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func scheduledActionPath(d *schema.ResourceData, id string) string {
+	path := fmt.Sprintf("/spaces/%s/scheduled_actions", d.Get("space_id").(string))
+	if id != "" {
+		path += "/" + id
+	}
+
+	return path
+}
+
+func scheduledActionQuery(d *schema.ResourceData) url.Values {
+	return url.Values{"environment.sys.id": {d.Get("env_id").(string)}}
+}
+
+func resourceScheduledActionCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" && d.NewValueKnown("entry_id") && d.NewValueKnown("asset_id") &&
+		d.Get("entry_id").(string) == "" && d.Get("asset_id").(string) == "" {
+		return fmt.Errorf("either entry_id or asset_id must be set")
+	}
+
+	// Actions that have already run stay in the state with their time, unless
+	// a change schedules a new action.
+	if d.Id() != "" && !hasChange(d, "space_id", "env_id", "entry_id", "asset_id", "action", "datetime", "timezone") {
+		return nil
+	}
+
+	if !d.NewValueKnown("datetime") {
+		return nil
+	}
+
+	datetime, err := time.Parse(time.RFC3339, d.Get("datetime").(string))
+	if err != nil {
+		return err
+	}
+
+	if !datetime.After(time.Now()) {
+		return fmt.Errorf("datetime %s is in the past, actions can only be scheduled for the future", d.Get("datetime").(string))
+	}
+
+	return nil
+}
+
+func resourceCreateScheduledAction(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	entity := newLink("Entry", d.Get("entry_id").(string))
+	if assetID := d.Get("asset_id").(string); assetID != "" {
+		entity = newLink("Asset", assetID)
+	}
+
+	body := &scheduledAction{
+		Entity:      entity,
+		Environment: newLink("Environment", d.Get("env_id").(string)),
+		ScheduledFor: &scheduledFor{
+			Datetime: d.Get("datetime").(string),
+			Timezone: d.Get("timezone").(string),
+		},
+		Action: d.Get("action").(string),
+	}
+
+	var created scheduledAction
+	if err = cmaRequest(client, http.MethodPost, scheduledActionPath(d, ""), scheduledActionQuery(d), 0, body, &created); err != nil {
+		return err
+	}
+
+	d.SetId(created.Sys.ID)
+
+	return setScheduledActionProperties(d, &created)
+}
+
+func resourceReadScheduledAction(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	var action scheduledAction
+	err = cmaRequest(client, http.MethodGet, scheduledActionPath(d, d.Id()), scheduledActionQuery(d), 0, nil, &action)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setScheduledActionProperties(d, &action)
+}
+
+// resourceDeleteScheduledAction cancels the action if it has not run yet.
+// Actions that have run or were canceled in the web app are left as they are.
+func resourceDeleteScheduledAction(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	path := scheduledActionPath(d, d.Id())
+
+	var action scheduledAction
+	err = cmaRequest(client, http.MethodGet, path, scheduledActionQuery(d), 0, nil, &action)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if action.Sys.Status != scheduledActionScheduled {
+		return nil
+	}
+
+	return retryTransient(d.Timeout(schema.TimeoutDelete), func() error {
+		err := cmaRequest(client, http.MethodDelete, path, scheduledActionQuery(d), 0, nil, nil)
+		if _, ok := err.(contentful.NotFoundError); ok {
+			return nil
+		}
+
+		return err
+	})
+}
+
+func setScheduledActionProperties(d *schema.ResourceData, action *scheduledAction) error {
+	values := map[string]interface{}{
+		"version": action.Sys.Version,
+		"status":  action.Sys.Status,
+		"action":  action.Action,
+	}
+
+	if action.Entity != nil {
+		switch action.Entity.Sys.LinkType {
+		case "Entry":
+			values["entry_id"] = action.Entity.Sys.ID
+		case "Asset":
+			values["asset_id"] = action.Entity.Sys.ID
+		}
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
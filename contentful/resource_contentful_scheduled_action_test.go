@@ -0,0 +1,248 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+func TestAccContentfulScheduledAction_Basic(t *testing.T) {
+	datetime := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulScheduledActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulScheduledActionConfig(datetime),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_scheduled_action.myaction", "status", "scheduled"),
+					resource.TestCheckResourceAttrPair("contentful_scheduled_action.myaction", "entry_id", "contentful_entry.myentry", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulScheduledAction_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+	tomorrow := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	dayAfter := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+	yesterday := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+
+	var firstID string
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckScheduledActionsCanceled(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulScheduledActionConfig(tomorrow),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_scheduled_action.myaction", "status", "scheduled"),
+					resource.TestCheckResourceAttr("contentful_scheduled_action.myaction", "entry_id", "tf-acc-test-entry"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources["contentful_scheduled_action.myaction"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Rescheduling cancels the action and schedules a new one.
+				Config: testFakeContentfulScheduledActionConfig(dayAfter),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_scheduled_action.myaction", "status", "scheduled"),
+					func(s *terraform.State) error {
+						action := fake.get(fmt.Sprintf("/spaces/%s/scheduled_actions/%s", fakeSpaceID, firstID))
+						if status := fakeSys(action)["status"]; status != "canceled" {
+							return fmt.Errorf("the rescheduled action is %s, want canceled", status)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config:      testFakeContentfulScheduledActionConfig(yesterday),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is in the past"),
+			},
+		},
+	})
+}
+
+func TestScheduledActionCustomizeDiff(t *testing.T) {
+	yesterday := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+
+	state := &terraform.InstanceState{
+		ID: "action",
+		Attributes: map[string]string{
+			"space_id": fakeSpaceID,
+			"env_id":   "master",
+			"entry_id": "tf-acc-test-entry",
+			"action":   "publish",
+			"datetime": yesterday,
+			"status":   "succeeded",
+		},
+	}
+
+	config := func(action string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"space_id": fakeSpaceID,
+			"env_id":   "master",
+			"entry_id": "tf-acc-test-entry",
+			"action":   action,
+			"datetime": yesterday,
+		})
+	}
+
+	// Terraform plans through SimpleDiff, which does not diff a replaced
+	// resource again as a new one.
+	provider := Provider().(*schema.Provider)
+	info := &terraform.InstanceInfo{Type: "contentful_scheduled_action"}
+
+	if _, err := provider.SimpleDiff(info, state, config("publish")); err != nil {
+		t.Errorf("an action that has run: unexpected error: %s", err)
+	}
+
+	// Any change replaces the action, so a new one would be scheduled for
+	// the same time.
+	_, err := provider.SimpleDiff(info, state, config("unpublish"))
+	if err == nil || !strings.Contains(err.Error(), "is in the past") {
+		t.Errorf("a replaced action: expected an error about the past datetime, got %v", err)
+	}
+}
+
+func testFakeCheckScheduledActionsCanceled(fake *fakeCMA) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "contentful_scheduled_action" {
+				continue
+			}
+
+			action := fake.get(fmt.Sprintf("/spaces/%s/scheduled_actions/%s", rs.Primary.Attributes["space_id"], rs.Primary.ID))
+			if action != nil && fakeSys(action)["status"] != "canceled" {
+				return fmt.Errorf("scheduled action %s is still %s", rs.Primary.ID, fakeSys(action)["status"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccContentfulScheduledActionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_scheduled_action" {
+			continue
+		}
+
+		path := fmt.Sprintf("/spaces/%s/scheduled_actions/%s", rs.Primary.Attributes["space_id"], rs.Primary.ID)
+
+		var action scheduledAction
+		err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &action)
+		if _, ok := err.(contentful.NotFoundError); ok {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if action.Sys.Status == scheduledActionScheduled {
+			return fmt.Errorf("scheduled action %s was not canceled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccContentfulScheduledActionConfig(datetime string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  name = "tf-acc-test-scheduled"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "Goes live tomorrow"
+    locale = "en-US"
+  }
+  published = false
+  archived  = false
+}
+
+resource "contentful_scheduled_action" "myaction" {
+  space_id = "%[1]s"
+  env_id = "%[2]s"
+  entry_id = contentful_entry.myentry.id
+  action = "publish"
+  datetime = "%[3]s"
+  timezone = "Europe/Berlin"
+}
+`, spaceID, envID, datetime)
+}
+
+func testFakeContentfulScheduledActionConfig(datetime string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id = "tf-acc-test-entry"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "Goes live tomorrow"
+    locale = "en-US"
+  }
+  published = false
+  archived  = false
+}
+
+resource "contentful_scheduled_action" "myaction" {
+  space_id = "%[1]s"
+  env_id = "master"
+  entry_id = contentful_entry.myentry.id
+  action = "publish"
+  datetime = "%[2]s"
+  timezone = "Europe/Berlin"
+}
+`, fakeSpaceID, datetime)
+}
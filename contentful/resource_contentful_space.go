@@ -1,6 +1,9 @@
 package contentful
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	contentful "github.com/regressivetech/contentful-go"
 )
@@ -12,6 +15,12 @@ func resourceContentfulSpace() *schema.Resource {
 		Update: resourceSpaceUpdate,
 		Delete: resourceSpaceDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:     schema.TypeInt,
@@ -23,9 +32,15 @@ func resourceContentfulSpace() *schema.Resource {
 			},
 			// Space specific props
 			"default_locale": {
-				Type:     schema.TypeString,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "en",
+				Description: "The code of the default locale of the space. Manage the default locale either here or with `default` on a contentful_locale, not both, or every apply switches it back and forth.",
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  "en",
+				Default:  true,
 			},
 		},
 	}
@@ -39,7 +54,9 @@ func resourceSpaceCreate(d *schema.ResourceData, m interface{}) (err error) {
 		DefaultLocale: d.Get("default_locale").(string),
 	}
 
-	err = client.Spaces.Upsert(space)
+	err = retryRateLimited(d.Timeout(schema.TimeoutCreate), func() error {
+		return client.Spaces.Upsert(space)
+	})
 	if err != nil {
 		return err
 	}
@@ -58,13 +75,30 @@ func resourceSpaceRead(d *schema.ResourceData, m interface{}) error {
 	client := m.(*contentful.Client)
 	spaceID := d.Id()
 
-	_, err := client.Spaces.Get(spaceID)
+	space, err := client.Spaces.Get(spaceID)
 	if _, ok := err.(contentful.NotFoundError); ok {
 		d.SetId("")
 		return nil
 	}
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	if err := updateSpaceProperties(d, space); err != nil {
+		return err
+	}
+
+	defaultLocale, err := getDefaultLocale(client, spaceID)
+	if err != nil {
+		return err
+	}
+
+	if defaultLocale != nil {
+		return d.Set("default_locale", defaultLocale.Code)
+	}
+
+	return nil
 }
 
 func resourceSpaceUpdate(d *schema.ResourceData, m interface{}) (err error) {
@@ -78,11 +112,19 @@ func resourceSpaceUpdate(d *schema.ResourceData, m interface{}) (err error) {
 
 	space.Name = d.Get("name").(string)
 
-	err = client.Spaces.Upsert(space)
+	err = retryRateLimited(d.Timeout(schema.TimeoutUpdate), func() error {
+		return client.Spaces.Upsert(space)
+	})
 	if err != nil {
 		return err
 	}
 
+	if d.HasChange("default_locale") {
+		if err := setDefaultLocale(client, spaceID, d.Get("default_locale").(string)); err != nil {
+			return err
+		}
+	}
+
 	return updateSpaceProperties(d, space)
 }
 
@@ -90,12 +132,18 @@ func resourceSpaceDelete(d *schema.ResourceData, m interface{}) (err error) {
 	client := m.(*contentful.Client)
 	spaceID := d.Id()
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("space %s has deletion_protection enabled, set deletion_protection = false and apply before destroying it", spaceID)
+	}
+
 	space, err := client.Spaces.Get(spaceID)
 	if err != nil {
 		return err
 	}
 
-	err = client.Spaces.Delete(space)
+	err = retryRateLimited(d.Timeout(schema.TimeoutDelete), func() error {
+		return client.Spaces.Delete(space)
+	})
 	if _, ok := err.(contentful.NotFoundError); ok {
 		return nil
 	}
@@ -116,3 +164,47 @@ func updateSpaceProperties(d *schema.ResourceData, space *contentful.Space) erro
 
 	return nil
 }
+
+// getDefaultLocale returns the default locale of the space. The space itself
+// only accepts the default locale on creation.
+func getDefaultLocale(client *contentful.Client, spaceID string) (*contentful.Locale, error) {
+	collection, err := client.Locales.List(spaceID).Next()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, locale := range collection.ToLocale() {
+		if locale.Default {
+			return locale, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// setDefaultLocale makes the existing locale with the given code the default
+// locale of the space.
+func setDefaultLocale(client *contentful.Client, spaceID, code string) error {
+	collection, err := client.Locales.List(spaceID).Next()
+	if err != nil {
+		return err
+	}
+
+	for _, locale := range collection.ToLocale() {
+		if locale.Code != code {
+			continue
+		}
+
+		if locale.Default {
+			return nil
+		}
+
+		// The default locale cannot have a fallback.
+		locale.Default = true
+		locale.FallbackCode = ""
+
+		return client.Locales.Upsert(spaceID, locale)
+	}
+
+	return fmt.Errorf("cannot change the default locale of space %s to %s: the locale does not exist, create it with a contentful_locale resource first", spaceID, code)
+}
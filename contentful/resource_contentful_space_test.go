@@ -2,13 +2,47 @@ package contentful
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	contentful "github.com/regressivetech/contentful-go"
 )
 
+func init() {
+	resource.AddTestSweepers("contentful_space", &resource.Sweeper{
+		Name: "contentful_space",
+		F:    testSweepSpaces,
+	})
+}
+
+func testSweepSpaces(region string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+
+	var spaces []*contentful.Space
+	if err := cmaListAll(client, "/spaces", nil, &spaces); err != nil {
+		return err
+	}
+
+	for _, space := range spaces {
+		// Never delete the shared space the other acceptance tests run in.
+		if !isTestAccObject(space.Name) || space.Sys.ID == spaceID {
+			continue
+		}
+
+		if err := client.Spaces.Delete(space); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccContentfulSpace_Basic(t *testing.T) {
 	t.Skip() // Space resource can only be tested when user has the rights to do so, if not, skip this test!
 	resource.Test(t, resource.TestCase{
@@ -19,12 +53,75 @@ func TestAccContentfulSpace_Basic(t *testing.T) {
 			{
 				Config: testAccContentfulSpaceConfig,
 				Check: resource.TestCheckResourceAttr(
-					"contentful_space.myspace", "name", "TF Acc Test Space"),
+					"contentful_space.myspace", "name", "tf-acc-test-space"),
 			},
 			{
 				Config: testAccContentfulSpaceUpdateConfig,
 				Check: resource.TestCheckResourceAttr(
-					"contentful_space.myspace", "name", "TF Acc Test Changed Space"),
+					"contentful_space.myspace", "name", "tf-acc-test-space-changed"),
+			},
+		},
+	})
+}
+
+func TestDeletionProtection(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resources := map[string]struct {
+		resource *schema.Resource
+		id       string
+		path     string
+	}{
+		"contentful_space":       {resourceContentfulSpace(), fakeSpaceID, "/spaces/" + fakeSpaceID},
+		"contentful_environment": {resourceContentfulEnvironment(), "tf-acc-test-env", "/spaces/" + fakeSpaceID + "/environments/tf-acc-test-env"},
+		"contentful_locale":      {resourceContentfulLocale(), "tf-acc-test-locale", "/spaces/" + fakeSpaceID + "/locales/tf-acc-test-locale"},
+	}
+
+	for name, r := range resources {
+		if fake.get(r.path) == nil {
+			fake.put(r.path, map[string]interface{}{
+				"sys": map[string]interface{}{"id": r.id, "version": 1},
+			})
+		}
+
+		d := schema.TestResourceDataRaw(t, r.resource.Schema, map[string]interface{}{
+			"space_id": fakeSpaceID,
+			"name":     "name",
+			"code":     "de",
+		})
+		d.SetId(r.id)
+
+		err := r.resource.Delete(d, fake.client())
+		if err == nil || !strings.Contains(err.Error(), "has deletion_protection enabled") {
+			t.Errorf("%s: expected destroy to be refused while deletion_protection is enabled, got %v", name, err)
+		}
+
+		if fake.get(r.path) == nil {
+			t.Errorf("%s: deleted despite deletion_protection", name)
+		}
+	}
+}
+
+func TestContentfulSpace_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_space", "/spaces/%[2]s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulSpaceConfig("tf-acc-test-space"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_space.myspace", "name", "tf-acc-test-space"),
+					resource.TestCheckResourceAttr("contentful_space.myspace", "default_locale", "en"),
+				),
+			},
+			{
+				Config: testFakeContentfulSpaceConfig("tf-acc-test-space-changed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_space.myspace", "name", "tf-acc-test-space-changed"),
+					resource.TestCheckResourceAttr("contentful_space.myspace", "version", "2"),
+				),
 			},
 		},
 	})
@@ -49,12 +146,23 @@ func testAccCheckContentfulSpaceDestroy(s *terraform.State) error {
 
 var testAccContentfulSpaceConfig = `
 resource "contentful_space" "myspace" {
-  name = "Playground"
+  name = "tf-acc-test-space"
+  deletion_protection = false
 }
 `
 
 var testAccContentfulSpaceUpdateConfig = `
 resource "contentful_space" "myspace" {
-  name = "TF Acc Test Changed Space"
+  name = "tf-acc-test-space-changed"
+  deletion_protection = false
 }
 `
+
+func testFakeContentfulSpaceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "contentful_space" "myspace" {
+  name = "%s"
+  deletion_protection = false
+}
+`, name)
+}
@@ -0,0 +1,174 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// tag is a Contentful tag.
+type tag struct {
+	Name string  `json:"name"`
+	Sys  *tagSys `json:"sys"`
+}
+
+type tagSys struct {
+	ID         string `json:"id,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Version    int    `json:"version,omitempty"`
+	Visibility string `json:"visibility,omitempty"`
+}
+
+func resourceContentfulTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateTag,
+		Read:   resourceReadTag,
+		Update: resourceUpdateTag,
+		Delete: resourceDeleteTag,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tag_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Contentful does not allow changing the visibility of a tag.
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "private",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+			},
+		},
+	}
+}
+
+func tagPath(d *schema.ResourceData, tagID string) string {
+	return fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", d.Get("space_id").(string), d.Get("env_id").(string), tagID)
+}
+
+func resourceCreateTag(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	tagID := d.Get("tag_id").(string)
+
+	body := &tag{
+		Name: d.Get("name").(string),
+		Sys: &tagSys{
+			ID:         tagID,
+			Type:       "Tag",
+			Visibility: d.Get("visibility").(string),
+		},
+	}
+
+	var created tag
+	if err = cmaRequest(client, http.MethodPut, tagPath(d, tagID), nil, 0, body, &created); err != nil {
+		return err
+	}
+
+	d.SetId(tagID)
+
+	return setTagProperties(d, &created)
+}
+
+func resourceUpdateTag(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	path := tagPath(d, d.Id())
+
+	var current tag
+	if err = cmaRequest(client, http.MethodGet, path, nil, 0, nil, &current); err != nil {
+		return err
+	}
+
+	body := &tag{
+		Name: d.Get("name").(string),
+		Sys: &tagSys{
+			Version: current.Sys.Version,
+		},
+	}
+
+	var updated tag
+	if err = cmaRequest(client, http.MethodPut, path, nil, current.Sys.Version, body, &updated); err != nil {
+		return err
+	}
+
+	return setTagProperties(d, &updated)
+}
+
+func resourceReadTag(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	var current tag
+	err = cmaRequest(client, http.MethodGet, tagPath(d, d.Id()), nil, 0, nil, &current)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setTagProperties(d, &current)
+}
+
+func resourceDeleteTag(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	path := tagPath(d, d.Id())
+
+	var current tag
+	err = cmaRequest(client, http.MethodGet, path, nil, 0, nil, &current)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return retryRateLimited(d.Timeout(schema.TimeoutDelete), func() error {
+		return cmaRequest(client, http.MethodDelete, path, nil, current.Sys.Version, nil, nil)
+	})
+}
+
+func setTagProperties(d *schema.ResourceData, t *tag) error {
+	if err := d.Set("tag_id", t.Sys.ID); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", t.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("visibility", t.Sys.Visibility); err != nil {
+		return err
+	}
+
+	return d.Set("version", t.Sys.Version)
+}
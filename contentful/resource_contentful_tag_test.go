@@ -0,0 +1,165 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+func init() {
+	resource.AddTestSweepers("contentful_tag", &resource.Sweeper{
+		Name:         "contentful_tag",
+		Dependencies: []string{"contentful_entry", "contentful_asset"},
+		F:            testSweepTags,
+	})
+}
+
+func testSweepTags(region string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+
+	var tags []*tag
+	if err := cmaListAll(client, fmt.Sprintf("/spaces/%s/environments/%s/tags", spaceID, envID), nil, &tags); err != nil {
+		return err
+	}
+
+	for _, t := range tags {
+		if !isTestAccObject(t.Sys.ID) {
+			continue
+		}
+
+		path := fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", spaceID, envID, t.Sys.ID)
+		if err := cmaRequest(client, http.MethodDelete, path, nil, t.Sys.Version, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestAccContentfulTag_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulTagConfig("tf-acc-test-tag"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulTagExists("contentful_tag.mytag"),
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "name", "tf-acc-test-tag"),
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "visibility", "public"),
+				),
+			},
+			{
+				Config: testAccContentfulTagConfig("tf-acc-test-tag-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulTagExists("contentful_tag.mytag"),
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "name", "tf-acc-test-tag-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulTag_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_tag", "/spaces/%s/environments/master/tags/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulTagConfig("Brand: Acme", "public"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "id", "tf-acc-test-tag"),
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "visibility", "public"),
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "version", "1"),
+				),
+			},
+			{
+				Config: testFakeContentfulTagConfig("Brand: Acme Corp", "public"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "name", "Brand: Acme Corp"),
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "version", "2"),
+				),
+			},
+			{
+				// The visibility of a tag cannot be changed, so the tag is
+				// replaced.
+				Config: testFakeContentfulTagConfig("Brand: Acme Corp", "private"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "visibility", "private"),
+					resource.TestCheckResourceAttr("contentful_tag.mytag", "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulTagExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not Found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*contentful.Client)
+		path := fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", rs.Primary.Attributes["space_id"], rs.Primary.Attributes["env_id"], rs.Primary.ID)
+
+		var t tag
+		return cmaRequest(client, http.MethodGet, path, nil, 0, nil, &t)
+	}
+}
+
+func testAccContentfulTagDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_tag" {
+			continue
+		}
+
+		path := fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", rs.Primary.Attributes["space_id"], rs.Primary.Attributes["env_id"], rs.Primary.ID)
+
+		var t tag
+		err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &t)
+		if _, ok := err.(contentful.NotFoundError); ok {
+			continue
+		}
+
+		return fmt.Errorf("tag still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccContentfulTagConfig(name string) string {
+	return fmt.Sprintf(`
+resource "contentful_tag" "mytag" {
+  space_id = "%s"
+  env_id = "%s"
+  tag_id = "tf-acc-test-tag"
+  name = "%s"
+  visibility = "public"
+}
+`, spaceID, envID, name)
+}
+
+func testFakeContentfulTagConfig(name, visibility string) string {
+	return fmt.Sprintf(`
+resource "contentful_tag" "mytag" {
+  space_id = "%s"
+  env_id = "master"
+  tag_id = "tf-acc-test-tag"
+  name = "%s"
+  visibility = "%s"
+}
+`, fakeSpaceID, name, visibility)
+}
@@ -0,0 +1,211 @@
+package contentful
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// taxonomyConcept is a concept of the taxonomy of an organization.
+type taxonomyConcept struct {
+	Sys          *contentful.Sys     `json:"sys,omitempty"`
+	URI          *string             `json:"uri"`
+	PrefLabel    map[string]string   `json:"prefLabel"`
+	AltLabels    map[string][]string `json:"altLabels"`
+	HiddenLabels map[string][]string `json:"hiddenLabels"`
+	Definition   map[string]string   `json:"definition"`
+	Note         map[string]string   `json:"note"`
+	Notations    []string            `json:"notations"`
+	Broader      []*link             `json:"broader"`
+	Related      []*link             `json:"related"`
+}
+
+func resourceContentfulTaxonomyConcept() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateTaxonomyConcept,
+		Read:   resourceReadTaxonomyConcept,
+		Update: resourceUpdateTaxonomyConcept,
+		Delete: resourceDeleteTaxonomyConcept,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"concept_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pref_label": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"alt_labels":    localizedLabelsSchema(),
+			"hidden_labels": localizedLabelsSchema(),
+			"definition": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"note": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"notations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"broader": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"related": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func expandTaxonomyConcept(d *schema.ResourceData) *taxonomyConcept {
+	notations := []string{}
+	for _, notation := range d.Get("notations").([]interface{}) {
+		notations = append(notations, notation.(string))
+	}
+
+	return &taxonomyConcept{
+		URI:          optionalString(d.Get("uri").(string)),
+		PrefLabel:    expandLocalizedString(d.Get("pref_label")),
+		AltLabels:    expandLocalizedLabels(d.Get("alt_labels").(*schema.Set)),
+		HiddenLabels: expandLocalizedLabels(d.Get("hidden_labels").(*schema.Set)),
+		Definition:   expandLocalizedString(d.Get("definition")),
+		Note:         expandLocalizedString(d.Get("note")),
+		Notations:    notations,
+		Broader:      expandLinks("TaxonomyConcept", d.Get("broader").(*schema.Set)),
+		Related:      expandLinks("TaxonomyConcept", d.Get("related").(*schema.Set)),
+	}
+}
+
+func resourceCreateTaxonomyConcept(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	var concept taxonomyConcept
+	if err = createTaxonomyObject(client, "concepts", d.Get("concept_id").(string), expandTaxonomyConcept(d), &concept); err != nil {
+		return err
+	}
+
+	d.SetId(concept.Sys.ID)
+
+	return setTaxonomyConceptProperties(d, &concept)
+}
+
+func resourceUpdateTaxonomyConcept(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	path := taxonomyPath(client, "concepts", d.Id())
+
+	var current taxonomyConcept
+	if err = cmaRequest(client, http.MethodGet, path, nil, 0, nil, &current); err != nil {
+		return err
+	}
+
+	concept := expandTaxonomyConcept(d)
+	operations := []patchOperation{
+		{Op: "add", Path: "/uri", Value: concept.URI},
+		{Op: "add", Path: "/prefLabel", Value: concept.PrefLabel},
+		{Op: "add", Path: "/altLabels", Value: concept.AltLabels},
+		{Op: "add", Path: "/hiddenLabels", Value: concept.HiddenLabels},
+		{Op: "add", Path: "/definition", Value: concept.Definition},
+		{Op: "add", Path: "/note", Value: concept.Note},
+		{Op: "add", Path: "/notations", Value: concept.Notations},
+		{Op: "add", Path: "/broader", Value: concept.Broader},
+		{Op: "add", Path: "/related", Value: concept.Related},
+	}
+
+	var updated taxonomyConcept
+	if err = cmaPatch(client, path, current.Sys.Version, operations, &updated); err != nil {
+		return err
+	}
+
+	return setTaxonomyConceptProperties(d, &updated)
+}
+
+func resourceReadTaxonomyConcept(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	var concept taxonomyConcept
+	err = cmaRequest(client, http.MethodGet, taxonomyPath(client, "concepts", d.Id()), nil, 0, nil, &concept)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setTaxonomyConceptProperties(d, &concept)
+}
+
+func resourceDeleteTaxonomyConcept(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	var concept taxonomyConcept
+	err = cmaRequest(client, http.MethodGet, taxonomyPath(client, "concepts", d.Id()), nil, 0, nil, &concept)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return retryRateLimited(d.Timeout(schema.TimeoutDelete), func() error {
+		return deleteTaxonomyObject(client, "concepts", d.Id(), concept.Sys.Version)
+	})
+}
+
+func setTaxonomyConceptProperties(d *schema.ResourceData, concept *taxonomyConcept) error {
+	notations := concept.Notations
+	if notations == nil {
+		notations = []string{}
+	}
+
+	values := map[string]interface{}{
+		"concept_id":    concept.Sys.ID,
+		"version":       concept.Sys.Version,
+		"uri":           stringValue(concept.URI),
+		"pref_label":    flattenLocalizedString(concept.PrefLabel),
+		"alt_labels":    flattenLocalizedLabels(concept.AltLabels),
+		"hidden_labels": flattenLocalizedLabels(concept.HiddenLabels),
+		"definition":    flattenLocalizedString(concept.Definition),
+		"note":          flattenLocalizedString(concept.Note),
+		"notations":     notations,
+		"broader":       flattenLinks(concept.Broader),
+		"related":       flattenLinks(concept.Related),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
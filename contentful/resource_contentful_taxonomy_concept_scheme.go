@@ -0,0 +1,173 @@
+package contentful
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// taxonomyConceptScheme groups concepts of the taxonomy of an organization.
+type taxonomyConceptScheme struct {
+	Sys         *contentful.Sys   `json:"sys,omitempty"`
+	URI         *string           `json:"uri"`
+	PrefLabel   map[string]string `json:"prefLabel"`
+	Definition  map[string]string `json:"definition"`
+	TopConcepts []*link           `json:"topConcepts"`
+	Concepts    []*link           `json:"concepts"`
+}
+
+func resourceContentfulTaxonomyConceptScheme() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreateTaxonomyConceptScheme,
+		Read:   resourceReadTaxonomyConceptScheme,
+		Update: resourceUpdateTaxonomyConceptScheme,
+		Delete: resourceDeleteTaxonomyConceptScheme,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"concept_scheme_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pref_label": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"definition": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"top_concepts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"concepts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func expandTaxonomyConceptScheme(d *schema.ResourceData) *taxonomyConceptScheme {
+	return &taxonomyConceptScheme{
+		URI:         optionalString(d.Get("uri").(string)),
+		PrefLabel:   expandLocalizedString(d.Get("pref_label")),
+		Definition:  expandLocalizedString(d.Get("definition")),
+		TopConcepts: expandLinks("TaxonomyConcept", d.Get("top_concepts").(*schema.Set)),
+		Concepts:    expandLinks("TaxonomyConcept", d.Get("concepts").(*schema.Set)),
+	}
+}
+
+func resourceCreateTaxonomyConceptScheme(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	var scheme taxonomyConceptScheme
+	if err = createTaxonomyObject(client, "concept-schemes", d.Get("concept_scheme_id").(string), expandTaxonomyConceptScheme(d), &scheme); err != nil {
+		return err
+	}
+
+	d.SetId(scheme.Sys.ID)
+
+	return setTaxonomyConceptSchemeProperties(d, &scheme)
+}
+
+func resourceUpdateTaxonomyConceptScheme(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+	path := taxonomyPath(client, "concept-schemes", d.Id())
+
+	var current taxonomyConceptScheme
+	if err = cmaRequest(client, http.MethodGet, path, nil, 0, nil, &current); err != nil {
+		return err
+	}
+
+	scheme := expandTaxonomyConceptScheme(d)
+	operations := []patchOperation{
+		{Op: "add", Path: "/uri", Value: scheme.URI},
+		{Op: "add", Path: "/prefLabel", Value: scheme.PrefLabel},
+		{Op: "add", Path: "/definition", Value: scheme.Definition},
+		{Op: "add", Path: "/topConcepts", Value: scheme.TopConcepts},
+		{Op: "add", Path: "/concepts", Value: scheme.Concepts},
+	}
+
+	var updated taxonomyConceptScheme
+	if err = cmaPatch(client, path, current.Sys.Version, operations, &updated); err != nil {
+		return err
+	}
+
+	return setTaxonomyConceptSchemeProperties(d, &updated)
+}
+
+func resourceReadTaxonomyConceptScheme(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	var scheme taxonomyConceptScheme
+	err = cmaRequest(client, http.MethodGet, taxonomyPath(client, "concept-schemes", d.Id()), nil, 0, nil, &scheme)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return setTaxonomyConceptSchemeProperties(d, &scheme)
+}
+
+func resourceDeleteTaxonomyConceptScheme(d *schema.ResourceData, m interface{}) (err error) {
+	client := m.(*contentful.Client)
+
+	var scheme taxonomyConceptScheme
+	err = cmaRequest(client, http.MethodGet, taxonomyPath(client, "concept-schemes", d.Id()), nil, 0, nil, &scheme)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return retryRateLimited(d.Timeout(schema.TimeoutDelete), func() error {
+		return deleteTaxonomyObject(client, "concept-schemes", d.Id(), scheme.Sys.Version)
+	})
+}
+
+func setTaxonomyConceptSchemeProperties(d *schema.ResourceData, scheme *taxonomyConceptScheme) error {
+	values := map[string]interface{}{
+		"concept_scheme_id": scheme.Sys.ID,
+		"version":           scheme.Sys.Version,
+		"uri":               stringValue(scheme.URI),
+		"pref_label":        flattenLocalizedString(scheme.PrefLabel),
+		"definition":        flattenLocalizedString(scheme.Definition),
+		"top_concepts":      flattenLinks(scheme.TopConcepts),
+		"concepts":          flattenLinks(scheme.Concepts),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
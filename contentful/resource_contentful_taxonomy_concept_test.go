@@ -0,0 +1,271 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+func TestAccContentfulTaxonomyConcept_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulTaxonomyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulTaxonomyConceptConfig("Footwear"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept.parent", "pref_label.en-US", "Footwear"),
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept.child", "broader.#", "1"),
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept_scheme.myscheme", "top_concepts.#", "1"),
+				),
+			},
+			{
+				Config: testAccContentfulTaxonomyConceptConfig("Shoes"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept.parent", "pref_label.en-US", "Shoes"),
+				),
+			},
+		},
+	})
+}
+
+func TestContentfulTaxonomy_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+	conceptPath := fmt.Sprintf("/organizations/%s/taxonomy/concepts/", fakeOrgID)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckTaxonomyDestroy(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulTaxonomyConfig("Footwear", "Schuhe"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept.parent", "id", "tf-acc-test-footwear"),
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept.parent", "pref_label.%", "2"),
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept.parent", "alt_labels.#", "1"),
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept.child", "broader.#", "1"),
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept.child", "related.#", "1"),
+					resource.TestCheckResourceAttrSet("contentful_taxonomy_concept.related", "concept_id"),
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept_scheme.myscheme", "concepts.#", "3"),
+					resource.TestCheckResourceAttr("contentful_contenttype.mycontenttype", "taxonomy.0.concept_scheme_id", "tf-acc-test-products"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "concepts.#", "1"),
+					testFakeCheckObject(fake, conceptPath+"tf-acc-test-footwear", "prefLabel", map[string]interface{}{"en-US": "Footwear", "de-DE": "Schuhe"}),
+				),
+			},
+			{
+				Config: testFakeContentfulTaxonomyConfig("Shoes", "Schuhe"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept.parent", "pref_label.en-US", "Shoes"),
+					resource.TestCheckResourceAttr("contentful_taxonomy_concept.parent", "version", "2"),
+				),
+			},
+			{
+				Config:   testFakeContentfulTaxonomyConfig("Shoes", "Schuhe"),
+				PlanOnly: true,
+			},
+			{
+				Config:      testFakeContentfulTaxonomyInvalidConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("exactly one of concept_scheme_id and concept_id"),
+			},
+		},
+	})
+}
+
+func testFakeCheckObject(fake *fakeCMA, path, key string, value interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		object := fake.get(path)
+		if object == nil {
+			return fmt.Errorf("%s does not exist", path)
+		}
+
+		if fmt.Sprint(object[key]) != fmt.Sprint(value) {
+			return fmt.Errorf("%s of %s is %v, want %v", key, path, object[key], value)
+		}
+
+		return nil
+	}
+}
+
+func testFakeCheckTaxonomyDestroy(fake *fakeCMA) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		collections := map[string]string{
+			"contentful_taxonomy_concept":        "concepts",
+			"contentful_taxonomy_concept_scheme": "concept-schemes",
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			collection, ok := collections[rs.Type]
+			if !ok {
+				continue
+			}
+
+			path := fmt.Sprintf("/organizations/%s/taxonomy/%s/%s", fakeOrgID, collection, rs.Primary.ID)
+			if fake.get(path) != nil {
+				return fmt.Errorf("%s still exists at %s", rs.Type, path)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccContentfulTaxonomyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*contentful.Client)
+	collections := map[string]string{
+		"contentful_taxonomy_concept":        "concepts",
+		"contentful_taxonomy_concept_scheme": "concept-schemes",
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		collection, ok := collections[rs.Type]
+		if !ok {
+			continue
+		}
+
+		err := cmaRequest(client, http.MethodGet, taxonomyPath(client, collection, rs.Primary.ID), nil, 0, nil, nil)
+		if _, ok := err.(contentful.NotFoundError); ok {
+			continue
+		}
+
+		return fmt.Errorf("%s still exists with id: %s", rs.Type, rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccContentfulTaxonomyConceptConfig(label string) string {
+	return fmt.Sprintf(`
+resource "contentful_taxonomy_concept" "parent" {
+  concept_id = "tf-acc-test-footwear"
+  pref_label = {
+    "en-US" = "%s"
+  }
+}
+
+resource "contentful_taxonomy_concept" "child" {
+  concept_id = "tf-acc-test-sneakers"
+  pref_label = {
+    "en-US" = "Sneakers"
+  }
+  broader = [contentful_taxonomy_concept.parent.id]
+}
+
+resource "contentful_taxonomy_concept_scheme" "myscheme" {
+  concept_scheme_id = "tf-acc-test-products"
+  pref_label = {
+    "en-US" = "Products"
+  }
+  top_concepts = [contentful_taxonomy_concept.parent.id]
+  concepts = [
+    contentful_taxonomy_concept.parent.id,
+    contentful_taxonomy_concept.child.id,
+  ]
+}
+`, label)
+}
+
+func testFakeContentfulTaxonomyConfig(label, germanLabel string) string {
+	return fmt.Sprintf(`
+resource "contentful_taxonomy_concept" "parent" {
+  concept_id = "tf-acc-test-footwear"
+  pref_label = {
+    "en-US" = "%[2]s"
+    "de-DE" = "%[3]s"
+  }
+  alt_labels {
+    locale = "en-US"
+    values = ["Shoes", "Boots"]
+  }
+  definition = {
+    "en-US" = "Anything worn on the feet."
+  }
+  notations = ["FW"]
+}
+
+resource "contentful_taxonomy_concept" "related" {
+  pref_label = {
+    "en-US" = "Socks"
+  }
+}
+
+resource "contentful_taxonomy_concept" "child" {
+  concept_id = "tf-acc-test-sneakers"
+  pref_label = {
+    "en-US" = "Sneakers"
+  }
+  broader = [contentful_taxonomy_concept.parent.id]
+  related = [contentful_taxonomy_concept.related.id]
+}
+
+resource "contentful_taxonomy_concept_scheme" "myscheme" {
+  concept_scheme_id = "tf-acc-test-products"
+  pref_label = {
+    "en-US" = "Products"
+  }
+  top_concepts = [contentful_taxonomy_concept.parent.id]
+  concepts = [
+    contentful_taxonomy_concept.parent.id,
+    contentful_taxonomy_concept.child.id,
+    contentful_taxonomy_concept.related.id,
+  ]
+}
+
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%[1]s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }
+  taxonomy {
+    concept_scheme_id = contentful_taxonomy_concept_scheme.myscheme.id
+    required = true
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id = "tf-acc-test-entry"
+  space_id = "%[1]s"
+  env_id = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "Hello, World!"
+    locale = "en-US"
+  }
+  concepts = [contentful_taxonomy_concept.child.id]
+  published = true
+  archived  = false
+}
+`, fakeSpaceID, label, germanLabel)
+}
+
+var testFakeContentfulTaxonomyInvalidConfig = fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%s"
+  env_id = "master"
+  content_type_id = "mycontenttype"
+  name = "tf-acc-test-1"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+  }
+  taxonomy {
+    required = true
+  }
+}
+`, fakeSpaceID)
@@ -1,6 +1,8 @@
 package contentful
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	contentful "github.com/regressivetech/contentful-go"
 )
@@ -12,6 +14,12 @@ func resourceContentfulWebhook() *schema.Resource {
 		Update: resourceUpdateWebhook,
 		Delete: resourceDeleteWebhook,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:     schema.TypeInt,
@@ -36,13 +44,15 @@ func resourceContentfulWebhook() *schema.Resource {
 				Default:  "",
 			},
 			"http_basic_auth_password": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				Default:   "",
 			},
 			"headers": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
 			},
 			"topics": {
 				Type: schema.TypeList,
@@ -69,7 +79,9 @@ func resourceCreateWebhook(d *schema.ResourceData, m interface{}) (err error) {
 		HTTPBasicPassword: d.Get("http_basic_auth_password").(string),
 	}
 
-	err = client.Webhooks.Upsert(spaceID, webhook)
+	err = retryRateLimited(d.Timeout(schema.TimeoutCreate), func() error {
+		return client.Webhooks.Upsert(spaceID, webhook)
+	})
 	if err != nil {
 		return err
 	}
@@ -101,7 +113,9 @@ func resourceUpdateWebhook(d *schema.ResourceData, m interface{}) (err error) {
 	webhook.HTTPBasicUsername = d.Get("http_basic_auth_username").(string)
 	webhook.HTTPBasicPassword = d.Get("http_basic_auth_password").(string)
 
-	err = client.Webhooks.Upsert(spaceID, webhook)
+	err = retryRateLimited(d.Timeout(schema.TimeoutUpdate), func() error {
+		return client.Webhooks.Upsert(spaceID, webhook)
+	})
 	if err != nil {
 		return err
 	}
@@ -144,7 +158,9 @@ func resourceDeleteWebhook(d *schema.ResourceData, m interface{}) (err error) {
 		return err
 	}
 
-	err = client.Webhooks.Delete(spaceID, webhook)
+	err = retryRateLimited(d.Timeout(schema.TimeoutDelete), func() error {
+		return client.Webhooks.Delete(spaceID, webhook)
+	})
 	if _, ok := err.(contentful.NotFoundError); ok {
 		return nil
 	}
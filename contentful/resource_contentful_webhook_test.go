@@ -9,6 +9,37 @@ import (
 	contentful "github.com/regressivetech/contentful-go"
 )
 
+func init() {
+	resource.AddTestSweepers("contentful_webhook", &resource.Sweeper{
+		Name: "contentful_webhook",
+		F:    testSweepWebhooks,
+	})
+}
+
+func testSweepWebhooks(region string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+
+	var webhooks []*contentful.Webhook
+	if err := cmaListAll(client, fmt.Sprintf("/spaces/%s/webhook_definitions", spaceID), nil, &webhooks); err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		if !isTestAccObject(webhook.Name) {
+			continue
+		}
+
+		if err := client.Webhooks.Delete(spaceID, webhook); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func TestAccContentfulWebhook_Basic(t *testing.T) {
 	var webhook contentful.Webhook
 
@@ -23,7 +54,7 @@ func TestAccContentfulWebhook_Basic(t *testing.T) {
 					testAccCheckContentfulWebhookExists("contentful_webhook.mywebhook", &webhook),
 					testAccCheckContentfulWebhookAttributes(&webhook, map[string]interface{}{
 						"space_id":                 spaceID,
-						"name":                     "webhook-name",
+						"name":                     "tf-acc-test-webhook",
 						"url":                      "https://www.example.com/test",
 						"http_basic_auth_username": "username",
 					}),
@@ -35,7 +66,7 @@ func TestAccContentfulWebhook_Basic(t *testing.T) {
 					testAccCheckContentfulWebhookExists("contentful_webhook.mywebhook", &webhook),
 					testAccCheckContentfulWebhookAttributes(&webhook, map[string]interface{}{
 						"space_id":                 spaceID,
-						"name":                     "webhook-name-updated",
+						"name":                     "tf-acc-test-webhook-updated",
 						"url":                      "https://www.example.com/test-updated",
 						"http_basic_auth_username": "username-updated",
 					}),
@@ -45,6 +76,33 @@ func TestAccContentfulWebhook_Basic(t *testing.T) {
 	})
 }
 
+func TestContentfulWebhook_Offline(t *testing.T) {
+	fake := newFakeCMA(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    fake.providers(),
+		CheckDestroy: testFakeCheckDestroy(fake, "contentful_webhook", "/spaces/%s/webhook_definitions/%s"),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeContentfulWebhookConfig("tf-acc-test-webhook", "https://www.example.com/test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_webhook.mywebhook", "name", "tf-acc-test-webhook"),
+					resource.TestCheckResourceAttr("contentful_webhook.mywebhook", "topics.#", "2"),
+					resource.TestCheckResourceAttr("contentful_webhook.mywebhook", "headers.header1", "header1-value"),
+				),
+			},
+			{
+				Config: testFakeContentfulWebhookConfig("tf-acc-test-webhook-updated", "https://www.example.com/test-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_webhook.mywebhook", "name", "tf-acc-test-webhook-updated"),
+					resource.TestCheckResourceAttr("contentful_webhook.mywebhook", "url", "https://www.example.com/test-updated"),
+					resource.TestCheckResourceAttr("contentful_webhook.mywebhook", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContentfulWebhookExists(n string, webhook *contentful.Webhook) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -136,7 +194,7 @@ var testAccContentfulWebhookConfig = `
 resource "contentful_webhook" "mywebhook" {
   space_id = "` + spaceID + `"
 
-  name = "webhook-name"
+  name = "tf-acc-test-webhook"
   url=  "https://www.example.com/test"
   topics = [
 	"Entry.create",
@@ -156,7 +214,7 @@ resource "contentful_webhook" "mywebhook" {
   space_id = "` + spaceID + `"
 
 
-  name = "webhook-name-updated"
+  name = "tf-acc-test-webhook-updated"
   url=  "https://www.example.com/test-updated"
   topics = [
 	"Entry.create",
@@ -171,3 +229,23 @@ resource "contentful_webhook" "mywebhook" {
   http_basic_auth_password = "password-updated"
 }
 `
+
+func testFakeContentfulWebhookConfig(name, url string) string {
+	return fmt.Sprintf(`
+resource "contentful_webhook" "mywebhook" {
+  space_id = "%s"
+
+  name = "%s"
+  url = "%s"
+  topics = [
+    "Entry.create",
+    "ContentType.create",
+  ]
+  headers = {
+    header1 = "header1-value"
+  }
+  http_basic_auth_username = "username"
+  http_basic_auth_password = "password"
+}
+`, fakeSpaceID, name, url)
+}
@@ -0,0 +1,167 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// Taxonomy concepts and concept schemes belong to the organization rather
+// than to a space.
+
+// organizationID returns the organization the provider was configured with.
+func organizationID(client *contentful.Client) string {
+	return client.Headers["X-Contentful-Organization"]
+}
+
+func taxonomyPath(client *contentful.Client, collection, id string) string {
+	path := fmt.Sprintf("/organizations/%s/taxonomy/%s", organizationID(client), collection)
+	if id != "" {
+		path += "/" + id
+	}
+
+	return path
+}
+
+// createTaxonomyObject creates a concept or concept scheme, with the given ID
+// if there is one.
+func createTaxonomyObject(client *contentful.Client, collection, id string, body, v interface{}) error {
+	if id == "" {
+		return cmaRequest(client, http.MethodPost, taxonomyPath(client, collection, ""), nil, 0, body, v)
+	}
+
+	return cmaRequest(client, http.MethodPut, taxonomyPath(client, collection, id), nil, 0, body, v)
+}
+
+// deleteTaxonomyObject deletes a concept or concept scheme. One that is
+// already gone counts as deleted.
+func deleteTaxonomyObject(client *contentful.Client, collection, id string, version int) error {
+	err := cmaRequest(client, http.MethodDelete, taxonomyPath(client, collection, id), nil, version, nil, nil)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+
+	return err
+}
+
+// localizedLabelsSchema is the schema of labels that can have several values
+// per locale, like the alternative labels of a concept.
+func localizedLabelsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"locale": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandLocalizedLabels(set *schema.Set) map[string][]string {
+	labels := map[string][]string{}
+	for _, raw := range set.List() {
+		label := raw.(map[string]interface{})
+
+		values := []string{}
+		for _, value := range label["values"].([]interface{}) {
+			values = append(values, value.(string))
+		}
+
+		labels[label["locale"].(string)] = values
+	}
+
+	return labels
+}
+
+func flattenLocalizedLabels(labels map[string][]string) []interface{} {
+	var locales []string
+	for locale, values := range labels {
+		if len(values) > 0 {
+			locales = append(locales, locale)
+		}
+	}
+
+	sort.Strings(locales)
+
+	var flattened []interface{}
+	for _, locale := range locales {
+		flattened = append(flattened, map[string]interface{}{
+			"locale": locale,
+			"values": labels[locale],
+		})
+	}
+
+	return flattened
+}
+
+// expandLocalizedString converts a map of locales to strings from the
+// configuration.
+func expandLocalizedString(raw interface{}) map[string]string {
+	values := map[string]string{}
+	for locale, value := range raw.(map[string]interface{}) {
+		values[locale] = value.(string)
+	}
+
+	return values
+}
+
+// flattenLocalizedString drops the locales without a value, which the API
+// returns as null.
+func flattenLocalizedString(values map[string]string) map[string]string {
+	flattened := map[string]string{}
+	for locale, value := range values {
+		if value != "" {
+			flattened[locale] = value
+		}
+	}
+
+	return flattened
+}
+
+func expandLinks(linkType string, set *schema.Set) []*link {
+	links := []*link{}
+	for _, id := range set.List() {
+		links = append(links, newLink(linkType, id.(string)))
+	}
+
+	return links
+}
+
+func flattenLinks(links []*link) []string {
+	ids := []string{}
+	for _, l := range links {
+		ids = append(ids, l.Sys.ID)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,149 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+// retryTransient calls f until it succeeds, fails with an error that is not
+// transient, or the timeout elapses. It must only wrap idempotent requests,
+// like reads and unversioned deletes: a versioned write that Contentful
+// committed before failing would be refused as a version mismatch when it is
+// sent again.
+func retryTransient(timeout time.Duration, f func() error) error {
+	return retryWhile(timeout, isTransientError, f)
+}
+
+// retryRateLimited calls f until it succeeds, fails with an error other than a
+// rate limit, or the timeout elapses. Rate limited requests were not
+// processed, so it may wrap requests that create objects and versioned
+// writes.
+func retryRateLimited(timeout time.Duration, f func() error) error {
+	return retryWhile(timeout, isRateLimitError, f)
+}
+
+func retryWhile(timeout time.Duration, retryable func(error) bool, f func() error) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		err := f()
+		if retryable(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// isTransientError reports whether err is a rate limit or server error that
+// is worth retrying.
+func isTransientError(err error) bool {
+	switch e := err.(type) {
+	case contentful.RateLimitExceededError:
+		return true
+	case contentful.ErrorResponse:
+		if e.Sys == nil {
+			return false
+		}
+
+		switch e.Sys.ID {
+		case "RateLimitExceeded", "ServerError", "BadGateway", "ServiceUnavailable":
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRateLimitError reports whether err is a 429 response.
+func isRateLimitError(err error) bool {
+	switch e := err.(type) {
+	case contentful.RateLimitExceededError:
+		return true
+	case contentful.ErrorResponse:
+		return e.Sys != nil && e.Sys.ID == "RateLimitExceeded"
+	}
+
+	return false
+}
+
+// environmentStatus is the part of an environment that tells whether it has
+// finished cloning its source environment.
+type environmentStatus struct {
+	Sys struct {
+		Status *link `json:"status"`
+	} `json:"sys"`
+}
+
+// waitForEnvironment waits until a newly created environment is ready to be
+// used, or fails if cloning failed.
+func waitForEnvironment(client *contentful.Client, spaceID, environmentID string, timeout time.Duration) error {
+	conf := &resource.StateChangeConf{
+		Pending: []string{"queued"},
+		Target:  []string{"ready"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			var environment environmentStatus
+
+			path := fmt.Sprintf("/spaces/%s/environments/%s", spaceID, environmentID)
+			err := cmaRequest(client, http.MethodGet, path, nil, 0, nil, &environment)
+			if _, ok := err.(contentful.NotFoundError); ok {
+				return nil, "", nil
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			if environment.Sys.Status == nil {
+				return environment, "ready", nil
+			}
+
+			return environment, environment.Sys.Status.Sys.ID, nil
+		},
+	}
+
+	_, err := conf.WaitForState()
+
+	return err
+}
+
+// waitForAssetProcessing waits until every file of the asset has been
+// processed and returns the asset as it is afterwards, since processing
+// increases its version.
+func waitForAssetProcessing(client *contentful.Client, spaceID, assetID string, timeout time.Duration) (*contentful.Asset, error) {
+	conf := &resource.StateChangeConf{
+		Pending: []string{"processing"},
+		Target:  []string{"processed"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			asset, err := client.Assets.Get(spaceID, assetID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if asset.Fields != nil {
+				for _, file := range asset.Fields.File {
+					if file != nil && file.URL == "" {
+						return asset, "processing", nil
+					}
+				}
+			}
+
+			return asset, "processed", nil
+		},
+	}
+
+	asset, err := conf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+
+	return asset.(*contentful.Asset), nil
+}
@@ -0,0 +1,112 @@
+package contentful
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	contentful "github.com/regressivetech/contentful-go"
+)
+
+func TestIsTransientError(t *testing.T) {
+	cases := []struct {
+		err       error
+		transient bool
+	}{
+		{nil, false},
+		{errors.New("connection reset"), false},
+		{contentful.RateLimitExceededError{}, true},
+		{contentful.NotFoundError{}, false},
+		{contentful.ErrorResponse{Sys: &contentful.Sys{ID: "ServerError"}}, true},
+		{contentful.ErrorResponse{Sys: &contentful.Sys{ID: "BadRequest"}}, false},
+	}
+
+	for _, c := range cases {
+		if transient := isTransientError(c.err); transient != c.transient {
+			t.Errorf("isTransientError(%#v) = %t, want %t", c.err, transient, c.transient)
+		}
+	}
+}
+
+func TestRetryRateLimited(t *testing.T) {
+	calls := 0
+	err := retryRateLimited(time.Minute, func() error {
+		calls++
+		if calls == 1 {
+			return contentful.RateLimitExceededError{}
+		}
+
+		return nil
+	})
+	if err != nil || calls != 2 {
+		t.Errorf("expected a rate limited request to be retried once, got %d calls and %v", calls, err)
+	}
+
+	// A server error may come after the object was created, so creating it
+	// again could duplicate it.
+	calls = 0
+	err = retryRateLimited(time.Minute, func() error {
+		calls++
+		return contentful.ErrorResponse{Sys: &contentful.Sys{ID: "ServerError"}}
+	})
+	if err == nil || calls != 1 {
+		t.Errorf("expected a server error not to be retried, got %d calls and %v", calls, err)
+	}
+}
+
+func TestRetryTransientGatewayError(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusBadGateway)
+			fmt.Fprint(w, "Bad Gateway")
+
+			return
+		}
+
+		fmt.Fprint(w, `{"sys": {"id": "master"}}`)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("fake-token")
+	client.BaseURL = server.URL
+
+	err := retryTransient(time.Minute, func() error {
+		return cmaRequest(client, http.MethodGet, "/spaces/fake-space/environments/master", nil, 0, nil, nil)
+	})
+	if err != nil || calls != 2 {
+		t.Errorf("expected a plain text 502 to be retried once, got %d calls and %v", calls, err)
+	}
+}
+
+func TestWaitForEnvironment(t *testing.T) {
+	fake := newFakeCMA(t)
+	path := fmt.Sprintf("/spaces/%s/environments/master", fakeSpaceID)
+
+	if err := waitForEnvironment(fake.client(), fakeSpaceID, "master", time.Second); err != nil {
+		t.Fatalf("ready environment: %s", err)
+	}
+
+	environment := fake.get(path)
+	fakeSys(environment)["status"] = fakeLink("Status", "failed")
+	fake.put(path, environment)
+
+	err := waitForEnvironment(fake.client(), fakeSpaceID, "master", time.Second)
+	if err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Fatalf("failed environment: expected an error, got %v", err)
+	}
+
+	fakeSys(environment)["status"] = fakeLink("Status", "queued")
+	fake.put(path, environment)
+
+	err = waitForEnvironment(fake.client(), fakeSpaceID, "master", time.Second)
+	if err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Fatalf("queued environment: expected a timeout, got %v", err)
+	}
+}
@@ -0,0 +1,5 @@
+data "contentful_spaces" "all" {}
+
+output "space_names" {
+  value = data.contentful_spaces.all.spaces[*].name
+}
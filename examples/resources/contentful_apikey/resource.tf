@@ -1,6 +1,7 @@
 resource "contentful_apikey" "myapikey" {
   space_id = "space-id"
 
-  name        = "api-key-name"
-  description = "a-great-key"
+  name         = "api-key-name"
+  description  = "a-great-key"
+  environments = ["master"]
 }
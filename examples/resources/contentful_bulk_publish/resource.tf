@@ -0,0 +1,12 @@
+resource "contentful_bulk_publish" "launch" {
+  space_id = "space-id"
+  env_id   = "master"
+  entries  = [contentful_entry.example_entry.id]
+  assets   = [contentful_asset.example_asset.id]
+
+  # Publish the entities again whenever their content changes.
+  triggers = {
+    example_entry = jsonencode(contentful_entry.example_entry.field)
+    example_asset = jsonencode(contentful_asset.example_asset.fields)
+  }
+}
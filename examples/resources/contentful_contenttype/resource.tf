@@ -2,10 +2,16 @@ resource "contentful_contenttype" "example_contenttype" {
   space_id        = "space-id"
   name            = "tf_linked"
   description     = "content type description"
-  display_field   = "asset_field"
+  display_field   = "title"
   content_type_id = "exampleContentType"
   env_id          = "environment-name"
 
+  field {
+    id       = "title"
+    name     = "Title"
+    type     = "Symbol"
+    required = true
+  }
   field {
     id   = "asset_field"
     name = "Asset Field"
@@ -31,3 +37,13 @@ resource "contentful_contenttype" "example_contenttype" {
     required = false
   }
 }
+
+resource "contentful_contenttype" "exported_contenttype" {
+  space_id        = "space-id"
+  env_id          = "environment-name"
+  name            = "Blog post"
+  content_type_id = "blogPost"
+
+  # A content type from the output of `contentful space export`.
+  definition_json = file("${path.module}/content_types/blogPost.json")
+}
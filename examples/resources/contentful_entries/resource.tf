@@ -0,0 +1,22 @@
+# countries.yaml:
+#
+# germany:
+#   content_type: country
+#   fields:
+#     name:
+#       en-US: Germany
+#       de-DE: Deutschland
+#     region:
+#       en-US:
+#         sys: { type: Link, linkType: Entry, id: europe }
+# europe:
+#   content_type: region
+#   fields:
+#     name:
+#       en-US: Europe
+resource "contentful_entries" "countries" {
+  space_id      = "space-id"
+  env_id        = "master"
+  document_json = jsonencode(yamldecode(file("${path.module}/countries.yaml")))
+  published     = true
+}
@@ -0,0 +1,7 @@
+resource "contentful_release" "launch" {
+  space_id = "space-id"
+  env_id   = "master"
+  title    = "Launch day"
+  entries  = [contentful_entry.landing_page.id, contentful_entry.announcement.id]
+  assets   = [contentful_asset.hero_image.id]
+}
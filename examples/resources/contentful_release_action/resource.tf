@@ -0,0 +1,11 @@
+resource "contentful_release_action" "publish_launch" {
+  space_id   = "space-id"
+  env_id     = "master"
+  release_id = contentful_release.launch.id
+  action     = "publish"
+
+  # Publish the release again whenever its contents change.
+  triggers = {
+    version = contentful_release.launch.version
+  }
+}
@@ -0,0 +1,8 @@
+resource "contentful_scheduled_action" "campaign_launch" {
+  space_id = "space-id"
+  env_id   = "master"
+  entry_id = contentful_entry.campaign.id
+  action   = "publish"
+  datetime = "2030-11-27T00:00:00+01:00"
+  timezone = "Europe/Berlin"
+}
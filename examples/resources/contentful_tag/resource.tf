@@ -0,0 +1,7 @@
+resource "contentful_tag" "example_tag" {
+  space_id   = "space-id"
+  env_id     = "master"
+  tag_id     = "brandAcme"
+  name       = "Brand: Acme"
+  visibility = "public"
+}
@@ -0,0 +1,22 @@
+resource "contentful_taxonomy_concept" "footwear" {
+  concept_id = "footwear"
+  pref_label = {
+    "en-US" = "Footwear"
+    "de-DE" = "Schuhe"
+  }
+  alt_labels {
+    locale = "en-US"
+    values = ["Shoes"]
+  }
+  definition = {
+    "en-US" = "Anything worn on the feet."
+  }
+}
+
+resource "contentful_taxonomy_concept" "sneakers" {
+  concept_id = "sneakers"
+  pref_label = {
+    "en-US" = "Sneakers"
+  }
+  broader = [contentful_taxonomy_concept.footwear.id]
+}
@@ -0,0 +1,11 @@
+resource "contentful_taxonomy_concept_scheme" "products" {
+  concept_scheme_id = "products"
+  pref_label = {
+    "en-US" = "Products"
+  }
+  top_concepts = [contentful_taxonomy_concept.footwear.id]
+  concepts = [
+    contentful_taxonomy_concept.footwear.id,
+    contentful_taxonomy_concept.sneakers.id,
+  ]
+}